@@ -0,0 +1,16 @@
+data "make_app_modules" "sheets_actions" {
+  app_name    = "google-sheets"
+  app_version = 2
+  type        = "action"
+}
+
+locals {
+  add_row = one([
+    for module in data.make_app_modules.sheets_actions.modules : module
+    if module.name == "addRow"
+  ])
+}
+
+output "add_row_parameters" {
+  value = jsondecode(local.add_row.parameters)
+}
@@ -0,0 +1,14 @@
+data "make_apps" "all" {}
+
+resource "make_connection" "sheets" {
+  name     = "Google Sheets"
+  app_name = "google-sheets"
+  team_id  = make_team.example.id
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.make_apps.all.names, "google-sheets")
+      error_message = "The google-sheets app is not available in this Make.com zone."
+    }
+  }
+}
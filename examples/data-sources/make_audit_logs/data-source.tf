@@ -0,0 +1,12 @@
+data "make_audit_logs" "connection_changes" {
+  organization_id = make_organization.example.id
+  entity_type     = "connection"
+  from            = timeadd(plantimestamp(), "-720h")
+}
+
+output "connection_changes" {
+  value = [
+    for entry in data.make_audit_logs.connection_changes.entries :
+    "${entry.created_at} ${entry.actor_name} ${entry.action} ${entry.entity_name}"
+  ]
+}
@@ -0,0 +1,11 @@
+data "make_custom_variables" "team" {
+  team_id = make_team.example.id
+}
+
+resource "make_scenario" "example" {
+  name    = "Order sync"
+  team_id = make_team.example.id
+  blueprint = templatefile("${path.module}/blueprint.json.tftpl", {
+    region = data.make_custom_variables.team.values["region"]
+  })
+}
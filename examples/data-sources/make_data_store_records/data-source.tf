@@ -0,0 +1,11 @@
+data "make_data_store_records" "countries" {
+  data_store_id = make_data_store.example.id
+  keys          = ["de", "cz"]
+}
+
+output "currencies" {
+  value = {
+    for code, record in data.make_data_store_records.countries.records :
+    code => jsondecode(record).currency
+  }
+}
@@ -0,0 +1,10 @@
+data "make_data_structures" "orders" {
+  team_id = make_team.example.id
+  name    = "Order"
+}
+
+resource "make_webhook" "orders" {
+  name              = "Incoming orders"
+  team_id           = make_team.example.id
+  data_structure_id = data.make_data_structures.orders.ids["Order"]
+}
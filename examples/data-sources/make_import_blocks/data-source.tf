@@ -0,0 +1,16 @@
+data "make_import_blocks" "marketing" {
+  team_id = "123"
+
+  # Objects already managed by this configuration
+  exclude_ids = [make_scenario.newsletter.id]
+}
+
+# terraform output -raw import_blocks > imports.tf
+output "import_blocks" {
+  value = data.make_import_blocks.marketing.import_blocks
+}
+
+# terraform output -raw adopted_config > adopted.tf
+output "adopted_config" {
+  value = data.make_import_blocks.marketing.config
+}
@@ -0,0 +1,17 @@
+data "make_organization_analytics" "last_month" {
+  organization_id = make_organization.example.id
+  from            = timeadd(plantimestamp(), "-720h")
+  to              = plantimestamp()
+  scenario_limit  = 10
+}
+
+output "top_scenarios" {
+  value = {
+    for scenario in data.make_organization_analytics.last_month.scenarios :
+    scenario.scenario_name => scenario.operations
+  }
+}
+
+output "daily_operations" {
+  value = [for day in data.make_organization_analytics.last_month.days : day.operations]
+}
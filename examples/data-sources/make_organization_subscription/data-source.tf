@@ -0,0 +1,16 @@
+data "make_organization_subscription" "current" {
+  organization_id = make_organization.example.id
+}
+
+# Only deploy the custom functions when the plan includes them
+resource "make_custom_function" "example" {
+  count = lookup(data.make_organization_subscription.current.features, "custom_functions", false) ? 1 : 0
+
+  team_id = make_team.example.id
+  name    = "formatAmount"
+  code    = file("${path.module}/functions/formatAmount.js")
+}
+
+output "operations_remaining" {
+  value = data.make_organization_subscription.current.operations_remaining
+}
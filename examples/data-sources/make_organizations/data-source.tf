@@ -0,0 +1,9 @@
+data "make_organizations" "all" {}
+
+# Restrict access to every organization the token manages
+resource "make_organization_ip_allowlist" "offices" {
+  for_each = data.make_organizations.all.ids
+
+  organization_id = each.value
+  ip_ranges       = ["203.0.113.0/24"]
+}
@@ -0,0 +1,9 @@
+data "make_provider_info" "current" {}
+
+# Refuse to apply against any zone other than EU1
+check "make_zone" {
+  assert {
+    condition     = data.make_provider_info.current.zone == "eu1.make.com"
+    error_message = "This configuration must be applied against the eu1.make.com zone."
+  }
+}
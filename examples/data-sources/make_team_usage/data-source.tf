@@ -0,0 +1,17 @@
+variable "billing_month" {
+  type    = string
+  default = "2026-09"
+}
+
+data "make_team_usage" "marketing" {
+  team_id = make_team.marketing.id
+  from    = "${var.billing_month}-01T00:00:00Z"
+  to      = timeadd("${var.billing_month}-01T00:00:00Z", "720h")
+}
+
+output "marketing_chargeback" {
+  value = {
+    operations       = data.make_team_usage.marketing.operations
+    data_transfer_mb = data.make_team_usage.marketing.data_transfer_mb
+  }
+}
@@ -0,0 +1,11 @@
+data "make_teams" "all" {
+  organization_id = make_organization.example.id
+}
+
+# Notify every team of the organization about failed scenario runs
+resource "make_notification_preferences" "teams" {
+  for_each = data.make_teams.all.ids
+
+  team_id         = each.value
+  scenario_errors = true
+}
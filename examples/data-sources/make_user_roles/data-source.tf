@@ -0,0 +1,7 @@
+data "make_user_roles" "team" {
+  category = "team"
+}
+
+output "team_admin_role_id" {
+  value = data.make_user_roles.team.ids["team_admin"]
+}
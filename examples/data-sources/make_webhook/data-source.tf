@@ -0,0 +1,3 @@
+data "make_webhook" "example" {
+  id = "webhook-123"
+}
@@ -0,0 +1,18 @@
+# Warn when the orders webhook has not received any data since it was
+# created or its URL was rotated
+check "orders_webhook_receives_data" {
+  data "make_webhook_logs" "orders" {
+    webhook_id = make_webhook.orders.id
+    limit      = 10
+  }
+
+  assert {
+    condition     = length(data.make_webhook_logs.orders.logs) > 0
+    error_message = "The orders webhook has not received any deliveries yet."
+  }
+
+  assert {
+    condition     = alltrue([for log in data.make_webhook_logs.orders.logs : log.status != "rejected"])
+    error_message = "Recent deliveries to the orders webhook were rejected."
+  }
+}
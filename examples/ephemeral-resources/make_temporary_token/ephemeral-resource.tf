@@ -0,0 +1,12 @@
+ephemeral "make_temporary_token" "deploy" {
+  label  = "deploy-pipeline"
+  scopes = ["scenarios:read", "scenarios:run"]
+}
+
+# Configure a second, scoped-down instance of the provider with the temporary
+# token. The token is never stored in plan or state and is revoked once
+# Terraform is done with it.
+provider "make" {
+  alias     = "scoped"
+  api_token = ephemeral.make_temporary_token.deploy.token
+}
@@ -0,0 +1,3 @@
+output "encoded_tenant" {
+  value = provider::make::base64url_encode("tenant:acme/eu")
+}
@@ -0,0 +1,9 @@
+resource "make_webhook" "orders" {
+  name = "Orders"
+  settings = {
+    forward_url = "https://example.com/hook?${provider::make::query_encode({
+      tenant = "acme"
+      event  = "order.created"
+    })}"
+  }
+}
@@ -10,6 +10,7 @@ terraform {
 provider "make" {
   api_token = var.make_api_token
   # base_url = "https://api.make.com/"  # Optional, defaults to this
+  # zone     = "eu1"                    # Optional, derives the base URL of a Make zone
 }
 
 variable "make_api_token" {
@@ -0,0 +1,14 @@
+resource "make_api_token" "ci" {
+  label  = "CI pipeline"
+  scopes = ["scenarios:read", "scenarios:run"]
+
+  # Rotate the token every quarter
+  keepers = {
+    rotation = "2026-q4"
+  }
+}
+
+output "ci_token" {
+  value     = make_api_token.ci.token
+  sensitive = true
+}
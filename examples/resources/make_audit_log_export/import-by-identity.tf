@@ -0,0 +1,6 @@
+import {
+  to = make_audit_log_export.example
+  identity = {
+    organization_id = "12345"
+  }
+}
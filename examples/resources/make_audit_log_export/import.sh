@@ -0,0 +1,3 @@
+# The audit log export can be imported using the organization ID. The token
+# and headers are never read back.
+terraform import make_audit_log_export.example 12345
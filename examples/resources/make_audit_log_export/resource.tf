@@ -0,0 +1,6 @@
+resource "make_audit_log_export" "splunk" {
+  organization_id  = make_organization.example.id
+  destination_type = "splunk"
+  url              = "https://splunk.example.com:8088/services/collector/event"
+  token            = var.splunk_hec_token
+}
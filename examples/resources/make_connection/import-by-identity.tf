@@ -0,0 +1,6 @@
+import {
+  to = make_connection.example
+  identity = {
+    id = "12345"
+  }
+}
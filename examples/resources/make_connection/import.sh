@@ -0,0 +1,9 @@
+# Connections can be imported using their ID, optionally prefixed by the team ID
+# when the API does not return the team of the connection
+terraform import make_connection.example 12345
+terraform import make_connection.example 678/12345
+
+# or by their name, which must be unique within the team. The team defaults to
+# the provider default_team_id.
+terraform import make_connection.example name=Invoicing
+terraform import make_connection.example 678/name=Invoicing
@@ -2,4 +2,29 @@ resource "make_connection" "example" {
   name     = "My Gmail Connection"
   app_name = "gmail"
   team_id  = "team-123"
-}
\ No newline at end of file
+}
+
+resource "make_connection" "salesforce" {
+  name     = "Salesforce"
+  app_name = "salesforce"
+  team_id  = "team-123"
+
+  # Verifying the credentials of some apps takes a while
+  timeouts {
+    create = "5m"
+  }
+}
+
+resource "make_connection" "database" {
+  name     = "Orders Database"
+  app_name = "postgres"
+  team_id  = "team-123"
+
+  # Typed parameters, checked against the parameters of the app connection
+  parameters = {
+    host     = "db.example.com"
+    port     = 5432
+    ssl      = true
+    password = var.database_password
+  }
+}
@@ -0,0 +1,22 @@
+resource "make_connection_batch" "slack_tenants" {
+  app_name = "slack"
+  team_id  = "team-123"
+  settings = {
+    workspace_region = "eu"
+  }
+
+  connections = {
+    tenant_a = {
+      name = "Slack - Tenant A"
+      settings = {
+        api_key = "tenant-a-key"
+      }
+    }
+    tenant_b = {
+      name = "Slack - Tenant B"
+      settings = {
+        api_key = "tenant-b-key"
+      }
+    }
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = make_custom_app.example
+  identity = {
+    name    = "acme-crm"
+    version = 1
+  }
+}
@@ -0,0 +1,2 @@
+# Custom apps can be imported using the app name and version separated by a slash
+terraform import make_custom_app.example acme-crm/1
@@ -0,0 +1,27 @@
+resource "make_custom_app" "example" {
+  name        = "acme-crm"
+  label       = "ACME CRM"
+  description = "Private integration with the ACME CRM"
+  theme       = "#6e2bc5"
+  icon        = "${path.module}/icon.png"
+  published   = true
+
+  base = jsonencode({
+    baseUrl = "https://api.acme.example/v1"
+    headers = {
+      authorization = "Bearer {{connection.accessToken}}"
+    }
+    response = {
+      error = { message = "{{body.error}}" }
+    }
+  })
+
+  common_data = jsonencode({
+    clientId     = var.acme_client_id
+    clientSecret = var.acme_client_secret
+  })
+
+  groups = jsonencode([
+    { label = "Contacts", modules = ["createContact", "getContact"] }
+  ])
+}
@@ -0,0 +1,8 @@
+import {
+  to = make_custom_app_invite.partner
+  identity = {
+    app_name        = "acme-crm"
+    app_version     = 1
+    organization_id = "org-789"
+  }
+}
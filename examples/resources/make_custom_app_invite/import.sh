@@ -0,0 +1,2 @@
+# Custom app invites can be imported using the app name, app version and organization ID separated by slashes
+terraform import make_custom_app_invite.partner acme-crm/1/org-789
@@ -0,0 +1,5 @@
+resource "make_custom_app_invite" "partner" {
+  app_name        = make_custom_app.example.name
+  app_version     = make_custom_app.example.version
+  organization_id = "org-789"
+}
@@ -0,0 +1,6 @@
+import {
+  to = make_custom_function.example
+  identity = {
+    id = "12345"
+  }
+}
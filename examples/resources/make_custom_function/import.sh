@@ -0,0 +1,4 @@
+# Custom functions can be imported using their ID, optionally prefixed by the
+# team ID
+terraform import make_custom_function.example 12345
+terraform import make_custom_function.example 678/12345
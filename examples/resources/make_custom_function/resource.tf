@@ -0,0 +1,10 @@
+resource "make_custom_function" "to_cents" {
+  team_id     = make_team.example.id
+  name        = "toCents"
+  description = "Converts a decimal amount to integer cents"
+  code        = <<-EOT
+    function toCents(amount) {
+      return Math.round(amount * 100);
+    }
+  EOT
+}
@@ -0,0 +1,6 @@
+import {
+  to = make_data_store_records.example
+  identity = {
+    data_store_id = "12345"
+  }
+}
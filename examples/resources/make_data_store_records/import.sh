@@ -0,0 +1,2 @@
+# Data store records can be imported using the data store ID. Every record of the data store is adopted.
+terraform import make_data_store_records.example 12345
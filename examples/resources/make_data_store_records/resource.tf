@@ -0,0 +1,14 @@
+resource "make_data_store_records" "countries" {
+  data_store_id = make_data_store.example.id
+
+  records = {
+    for country in csvdecode(file("${path.module}/countries.csv")) :
+    country.code => jsonencode({
+      name     = country.name
+      currency = country.currency
+    })
+  }
+
+  # Scenarios keep these records up to date, so only seed them
+  ignore_drift = true
+}
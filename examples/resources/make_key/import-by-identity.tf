@@ -0,0 +1,6 @@
+import {
+  to = make_key.example
+  identity = {
+    id = "12345"
+  }
+}
@@ -0,0 +1,5 @@
+# Keys can be imported using their ID, optionally prefixed by the team ID. The
+# secret parameters are never read back, so parameters_wo_version should be
+# bumped to send them again.
+terraform import make_key.example 12345
+terraform import make_key.example 678/12345
@@ -0,0 +1,12 @@
+resource "make_key" "payload_encryption" {
+  team_id = make_team.example.id
+  name    = "Payload encryption"
+  type    = "aes-key"
+
+  parameters_wo = {
+    key = var.payload_encryption_key
+  }
+
+  # Bump to send a rotated key to Make.com
+  parameters_wo_version = 1
+}
@@ -0,0 +1,6 @@
+import {
+  to = make_notification_preferences.team
+  identity = {
+    id = "12345"
+  }
+}
@@ -0,0 +1,5 @@
+# Team notification preferences can be imported using the team ID
+terraform import make_notification_preferences.team 12345
+
+# The preferences of the user the API token belongs to are imported with "me"
+terraform import make_notification_preferences.me me
@@ -0,0 +1,13 @@
+# Notify the team about every failed run, and about warnings once a day
+resource "make_notification_preferences" "team" {
+  team_id                = make_team.example.id
+  scenario_errors        = true
+  scenario_warnings      = true
+  scenario_deactivations = true
+  frequency              = "daily"
+}
+
+# Preferences of the user the API token belongs to
+resource "make_notification_preferences" "me" {
+  scenario_warnings = false
+}
@@ -0,0 +1,7 @@
+import {
+  to = make_organization_invite.example
+  identity = {
+    organization_id = "org-123"
+    id              = "invite-789"
+  }
+}
@@ -0,0 +1,2 @@
+# Organization invitations can be imported using the organization ID and invitation ID separated by a slash
+terraform import make_organization_invite.example org-123/invite-789
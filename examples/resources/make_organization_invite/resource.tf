@@ -0,0 +1,6 @@
+resource "make_organization_invite" "example" {
+  organization_id = make_organization.example.id
+  email           = "new.hire@example.com"
+  role            = "member"
+  team_ids        = [make_team.example.id]
+}
@@ -0,0 +1,6 @@
+import {
+  to = make_organization_ip_allowlist.example
+  identity = {
+    organization_id = "12345"
+  }
+}
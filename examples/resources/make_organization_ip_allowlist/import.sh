@@ -0,0 +1,2 @@
+# The IP allowlist can be imported using the organization ID
+terraform import make_organization_ip_allowlist.example 12345
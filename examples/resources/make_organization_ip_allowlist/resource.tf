@@ -0,0 +1,8 @@
+resource "make_organization_ip_allowlist" "corporate" {
+  organization_id = make_organization.example.id
+
+  ip_ranges = [
+    "203.0.113.0/24", # Office network
+    "198.51.100.17",  # VPN egress, also used by CI
+  ]
+}
@@ -0,0 +1,7 @@
+import {
+  to = make_organization_member.example
+  identity = {
+    organization_id = "org-123"
+    user_id         = "user-456"
+  }
+}
@@ -0,0 +1,2 @@
+# Organization memberships can be imported using the organization ID and user ID separated by a slash
+terraform import make_organization_member.example org-123/user-456
@@ -0,0 +1,5 @@
+resource "make_organization_member" "example" {
+  organization_id = make_organization.example.id
+  user_id         = "user-456"
+  role            = "admin"
+}
@@ -0,0 +1,2 @@
+# Organization settings can be imported using the organization ID
+terraform import make_organization_settings.example 12345
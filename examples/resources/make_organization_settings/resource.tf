@@ -0,0 +1,9 @@
+resource "make_organization_settings" "example" {
+  organization_id = make_organization.example.id
+  timezone        = "Europe/Prague"
+  country_code    = "CZ"
+
+  features = {
+    ai_assistant = false
+  }
+}
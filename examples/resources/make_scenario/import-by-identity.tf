@@ -0,0 +1,6 @@
+import {
+  to = make_scenario.example
+  identity = {
+    id = "12345"
+  }
+}
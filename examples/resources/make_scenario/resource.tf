@@ -3,4 +3,43 @@ resource "make_scenario" "example" {
   description = "A scenario managed by Terraform"
   active      = true
   team_id     = "team-123"
-}
\ No newline at end of file
+
+  execution_retention_days = 30
+
+  export_executions_to = {
+    type = "data_store"
+    id   = "data-store-123"
+  }
+
+  scheduling = {
+    type     = "indefinitely"
+    interval = 900
+  }
+
+  blueprint = jsonencode({
+    name     = "My Terraform Scenario"
+    flow     = []
+    metadata = { version = 1 }
+  })
+}
+
+# Manage only metadata, scheduling and activation of a scenario whose
+# modules are edited in the Make UI
+resource "make_scenario" "ui_authored" {
+  name             = "UI Authored Scenario"
+  active           = true
+  team_id          = "team-123"
+  manage_blueprint = false
+
+  scheduling = {
+    type = "daily"
+  }
+}
+
+# Delete the scenario right away when destroyed, without stopping it and
+# waiting for its running executions first
+resource "make_scenario" "disposable" {
+  name                  = "Disposable Scenario"
+  team_id               = "team-123"
+  force_stop_on_destroy = false
+}
@@ -0,0 +1,6 @@
+import {
+  to = make_scim_group.example
+  identity = {
+    id = "6f1d2c3b-4a5e-4f60-8b7c-9d0e1f2a3b4c"
+  }
+}
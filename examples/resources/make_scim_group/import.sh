@@ -0,0 +1,2 @@
+# SCIM groups can be imported using their SCIM ID
+terraform import make_scim_group.example 6f1d2c3b-4a5e-4f60-8b7c-9d0e1f2a3b4c
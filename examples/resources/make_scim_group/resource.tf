@@ -0,0 +1,11 @@
+resource "make_scim_group" "finance" {
+  display_name = "Finance"
+  external_id  = "00g1a2b3c4d5e6f7g8h9"
+  team_id      = make_team.finance.id
+  team_role    = "team_member"
+
+  members = [
+    make_scim_user.jane.id,
+    make_scim_user.john.id,
+  ]
+}
@@ -0,0 +1,6 @@
+import {
+  to = make_scim_user.example
+  identity = {
+    id = "2c9f8e61-7d2a-4b8e-9a0f-3e5d6c7b8a90"
+  }
+}
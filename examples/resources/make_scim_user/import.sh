@@ -0,0 +1,2 @@
+# SCIM users can be imported using their SCIM ID
+terraform import make_scim_user.example 2c9f8e61-7d2a-4b8e-9a0f-3e5d6c7b8a90
@@ -0,0 +1,9 @@
+resource "make_scim_user" "jane" {
+  user_name   = "jane.doe@example.com"
+  external_id = "00u1a2b3c4d5e6f7g8h9"
+  given_name  = "Jane"
+  family_name = "Doe"
+
+  # Keep the user's scenarios and connections when offboarding
+  deactivate_on_destroy = true
+}
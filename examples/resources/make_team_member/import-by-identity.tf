@@ -0,0 +1,7 @@
+import {
+  to = make_team_member.example
+  identity = {
+    team_id = "team-123"
+    user_id = "user-456"
+  }
+}
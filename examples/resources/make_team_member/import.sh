@@ -0,0 +1,2 @@
+# Team memberships can be imported using the team ID and user ID separated by a slash
+terraform import make_team_member.example team-123/user-456
@@ -0,0 +1,5 @@
+resource "make_team_member" "example" {
+  team_id = make_team.example.id
+  user_id = "user-456"
+  role    = "team_member"
+}
@@ -0,0 +1,7 @@
+import {
+  to = make_team_variable.example
+  identity = {
+    team_id = "team-123"
+    name    = "order_threshold"
+  }
+}
@@ -0,0 +1,2 @@
+# Team variables can be imported using the team ID and variable name separated by a slash
+terraform import make_team_variable.example team-123/order_threshold
@@ -0,0 +1,14 @@
+resource "make_team_variable" "threshold" {
+  team_id = make_team.example.id
+  name    = "order_threshold"
+  type    = "number"
+  value   = "250"
+}
+
+resource "make_team_variable" "api_key" {
+  team_id   = make_team.example.id
+  name      = "partner_api_key"
+  type      = "string"
+  value     = var.partner_api_key
+  is_secret = true
+}
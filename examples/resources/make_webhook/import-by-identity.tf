@@ -0,0 +1,6 @@
+import {
+  to = make_webhook.example
+  identity = {
+    id = "12345"
+  }
+}
@@ -2,4 +2,54 @@ resource "make_webhook" "example" {
   name    = "My Webhook"
   team_id = "team-456"
   active  = true
-}
\ No newline at end of file
+
+  settings = {
+    method  = true
+    headers = true
+  }
+}
+
+resource "make_webhook" "inbox" {
+  name    = "Invoices Inbox"
+  team_id = "team-456"
+  type    = "gateway-mailhook"
+}
+
+resource "make_webhook" "orders" {
+  name              = "Orders Webhook"
+  rotate_url        = "2026-q4"
+  team_id           = "team-456"
+  data_structure_id = "data-structure-789"
+  learning_mode     = false
+
+  ip_restrictions = [
+    "203.0.113.10",
+    "198.51.100.0/24",
+  ]
+
+  queue = {
+    max_size      = 1000
+    stop_on_error = true
+  }
+}
+
+resource "make_webhook" "sync" {
+  name    = "Synchronous Webhook"
+  team_id = "team-456"
+
+  response = {
+    status_code = 202
+    body        = jsonencode({ status = "queued" })
+    headers = {
+      "Content-Type" = "application/json"
+    }
+  }
+}
+
+# Stop the scenarios triggered by the webhook and detach it from them when the
+# webhook is destroyed, instead of failing while scenarios still use it
+resource "make_webhook" "temporary" {
+  name          = "Temporary Webhook"
+  team_id       = "team-456"
+  force_destroy = true
+}
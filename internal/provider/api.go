@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// MakeAPI is the Make API as used by resources, data sources and ephemeral
+// resources, so that they can be tested against fakes instead of the network.
+// apiClient implements it over HTTP. A fake can embed MakeAPI and only
+// implement the methods a test exercises.
+type MakeAPI interface {
+	CreateScenario(ctx context.Context, req makeapi.ScenarioRequest) (*makeapi.ScenarioResponse, error)
+	GetScenario(ctx context.Context, id string) (*makeapi.ScenarioResponse, error)
+	ListScenarios(ctx context.Context, teamID string) ([]makeapi.ScenarioResponse, error)
+	UpdateScenario(ctx context.Context, id string, prior, req makeapi.ScenarioRequest) (*makeapi.ScenarioResponse, error)
+	GetScenarioBlueprint(ctx context.Context, id string) (string, error)
+	StopScenario(ctx context.Context, id string) (*makeapi.ScenarioResponse, error)
+	ListScenarioExecutions(ctx context.Context, id, status string) ([]makeapi.ScenarioExecution, error)
+	DeleteScenario(ctx context.Context, id string) error
+
+	CreateConnection(ctx context.Context, req makeapi.ConnectionRequest) (*makeapi.ConnectionResponse, error)
+	GetConnection(ctx context.Context, id string) (*makeapi.ConnectionResponse, error)
+	ListConnections(ctx context.Context, teamID string) ([]makeapi.ConnectionResponse, error)
+	UpdateConnection(ctx context.Context, id string, prior, req makeapi.ConnectionRequest) (*makeapi.ConnectionResponse, error)
+	DeleteConnection(ctx context.Context, id string) error
+
+	CreateWebhook(ctx context.Context, req makeapi.WebhookRequest) (*makeapi.WebhookResponse, error)
+	GetWebhook(ctx context.Context, id string) (*makeapi.WebhookResponse, error)
+	ListWebhooks(ctx context.Context, teamID string) ([]makeapi.WebhookResponse, error)
+	UpdateWebhook(ctx context.Context, id string, prior, req makeapi.WebhookRequest) (*makeapi.WebhookResponse, error)
+	DeleteWebhook(ctx context.Context, id string, confirmed bool) error
+	RotateWebhookURL(ctx context.Context, id string) (*makeapi.WebhookResponse, error)
+	SetWebhookEnabled(ctx context.Context, id string, enabled bool) error
+	SetWebhookLearning(ctx context.Context, id string, enabled bool) error
+	ListWebhookLogs(ctx context.Context, webhookID string, limit int) ([]makeapi.WebhookLog, error)
+
+	CreateTeam(ctx context.Context, req makeapi.TeamRequest) (*makeapi.TeamResponse, error)
+	GetTeam(ctx context.Context, id string) (*makeapi.TeamResponse, error)
+	UpdateTeam(ctx context.Context, id string, prior, req makeapi.TeamRequest) (*makeapi.TeamResponse, error)
+	DeleteTeam(ctx context.Context, id string) error
+	ListTeams(ctx context.Context, organizationID string) ([]makeapi.TeamResponse, error)
+
+	AddTeamMember(ctx context.Context, teamID string, req makeapi.TeamMemberRequest) (*makeapi.TeamMemberResponse, error)
+	GetTeamMember(ctx context.Context, teamID, userID string) (*makeapi.TeamMemberResponse, error)
+	UpdateTeamMember(ctx context.Context, teamID, userID string, req makeapi.TeamMemberRequest) (*makeapi.TeamMemberResponse, error)
+	RemoveTeamMember(ctx context.Context, teamID, userID string) error
+
+	GetTeamUsage(ctx context.Context, teamID, from, to string) ([]makeapi.DailyUsage, error)
+
+	CreateOrganization(ctx context.Context, req makeapi.OrganizationRequest) (*makeapi.OrganizationResponse, error)
+	GetOrganization(ctx context.Context, id string) (*makeapi.OrganizationResponse, error)
+	ListOrganizations(ctx context.Context) ([]makeapi.OrganizationResponse, error)
+	UpdateOrganization(ctx context.Context, id string, prior, req makeapi.OrganizationRequest) (*makeapi.OrganizationResponse, error)
+	DeleteOrganization(ctx context.Context, id string) error
+
+	GetOrganizationSettings(ctx context.Context, organizationID string) (*makeapi.OrganizationSettings, error)
+	UpdateOrganizationSettings(ctx context.Context, organizationID string, settings makeapi.OrganizationSettings) (*makeapi.OrganizationSettings, error)
+	GetOrganizationSubscription(ctx context.Context, organizationID string) (*makeapi.OrganizationSubscription, error)
+	GetOrganizationAnalytics(ctx context.Context, organizationID, from, to string) (*makeapi.OrganizationAnalytics, error)
+
+	AddOrganizationMember(ctx context.Context, organizationID string, req makeapi.OrganizationMemberRequest) (*makeapi.OrganizationMemberResponse, error)
+	GetOrganizationMember(ctx context.Context, organizationID, userID string) (*makeapi.OrganizationMemberResponse, error)
+	UpdateOrganizationMember(ctx context.Context, organizationID, userID string, req makeapi.OrganizationMemberRequest) (*makeapi.OrganizationMemberResponse, error)
+	RemoveOrganizationMember(ctx context.Context, organizationID, userID string) error
+
+	CreateOrganizationInvite(ctx context.Context, organizationID string, req makeapi.OrganizationInviteRequest) (*makeapi.OrganizationInviteResponse, error)
+	GetOrganizationInvite(ctx context.Context, organizationID, id string) (*makeapi.OrganizationInviteResponse, error)
+	RevokeOrganizationInvite(ctx context.Context, organizationID, id string) error
+
+	CreateDataStore(ctx context.Context, req makeapi.DataStoreRequest) (*makeapi.DataStoreResponse, error)
+	GetDataStore(ctx context.Context, id string) (*makeapi.DataStoreResponse, error)
+	UpdateDataStore(ctx context.Context, id string, prior, req makeapi.DataStoreRequest) (*makeapi.DataStoreResponse, error)
+	ListDataStores(ctx context.Context, teamID string) ([]makeapi.DataStoreResponse, error)
+	DeleteDataStore(ctx context.Context, id string) error
+
+	ListDataStoreRecords(ctx context.Context, dataStoreID string) ([]makeapi.DataStoreRecord, error)
+	WriteDataStoreRecords(ctx context.Context, dataStoreID string, records []makeapi.DataStoreRecord, overwrite bool) error
+	DeleteDataStoreRecords(ctx context.Context, dataStoreID string, keys []string) error
+
+	ListDataStructures(ctx context.Context, teamID string) ([]makeapi.DataStructureResponse, error)
+
+	CreateTeamVariable(ctx context.Context, teamID string, req makeapi.CustomVariableRequest) (*makeapi.CustomVariableResponse, error)
+	GetTeamVariable(ctx context.Context, teamID, name string) (*makeapi.CustomVariableResponse, error)
+	UpdateTeamVariable(ctx context.Context, teamID, name string, req makeapi.CustomVariableRequest) (*makeapi.CustomVariableResponse, error)
+	DeleteTeamVariable(ctx context.Context, teamID, name string) error
+	ListTeamVariables(ctx context.Context, teamID string) ([]makeapi.CustomVariableResponse, error)
+	ListOrganizationVariables(ctx context.Context, organizationID string) ([]makeapi.CustomVariableResponse, error)
+
+	CreateCustomApp(ctx context.Context, req makeapi.CustomAppRequest) (*makeapi.CustomAppResponse, error)
+	GetCustomApp(ctx context.Context, name string, version int64) (*makeapi.CustomAppResponse, error)
+	UpdateCustomApp(ctx context.Context, name string, version int64, req makeapi.CustomAppRequest) (*makeapi.CustomAppResponse, error)
+	DeleteCustomApp(ctx context.Context, name string, version int64) error
+	GetCustomAppSection(ctx context.Context, name string, version int64, section string) (json.RawMessage, error)
+	SetCustomAppSection(ctx context.Context, name string, version int64, section string, content json.RawMessage) error
+	UploadCustomAppIcon(ctx context.Context, name string, version int64, icon []byte) error
+	SetCustomAppPublished(ctx context.Context, name string, version int64, published bool) error
+
+	CreateCustomAppInvite(ctx context.Context, name string, version int64, organizationID string) (*makeapi.CustomAppInviteResponse, error)
+	GetCustomAppInvite(ctx context.Context, name string, version int64, organizationID string) (*makeapi.CustomAppInviteResponse, error)
+	DeleteCustomAppInvite(ctx context.Context, name string, version int64, organizationID string) error
+
+	CreateCustomFunction(ctx context.Context, req makeapi.CustomFunctionRequest) (*makeapi.CustomFunctionResponse, error)
+	GetCustomFunction(ctx context.Context, id string) (*makeapi.CustomFunctionResponse, error)
+	UpdateCustomFunction(ctx context.Context, id string, prior, req makeapi.CustomFunctionRequest) (*makeapi.CustomFunctionResponse, error)
+	DeleteCustomFunction(ctx context.Context, id string) error
+
+	CreateKey(ctx context.Context, req makeapi.KeyRequest) (*makeapi.KeyResponse, error)
+	GetKey(ctx context.Context, id string) (*makeapi.KeyResponse, error)
+	UpdateKey(ctx context.Context, id string, req makeapi.KeyRequest) (*makeapi.KeyResponse, error)
+	DeleteKey(ctx context.Context, id string) error
+
+	CreateScimUser(ctx context.Context, user makeapi.ScimUser) (*makeapi.ScimUser, error)
+	GetScimUser(ctx context.Context, id string) (*makeapi.ScimUser, error)
+	UpdateScimUser(ctx context.Context, id string, user makeapi.ScimUser) (*makeapi.ScimUser, error)
+	DeleteScimUser(ctx context.Context, id string) error
+
+	CreateScimGroup(ctx context.Context, group makeapi.ScimGroup) (*makeapi.ScimGroup, error)
+	GetScimGroup(ctx context.Context, id string) (*makeapi.ScimGroup, error)
+	UpdateScimGroup(ctx context.Context, id string, group makeapi.ScimGroup) (*makeapi.ScimGroup, error)
+	DeleteScimGroup(ctx context.Context, id string) error
+
+	GetOrganizationIPAllowlist(ctx context.Context, organizationID string) (*makeapi.OrganizationIPAllowlist, error)
+	SetOrganizationIPAllowlist(ctx context.Context, organizationID string, allowlist makeapi.OrganizationIPAllowlist) (*makeapi.OrganizationIPAllowlist, error)
+
+	ListAuditLogs(ctx context.Context, organizationID string, filter makeapi.AuditLogFilter, maxEntries int) ([]makeapi.AuditLogEntry, error)
+	GetAuditLogExport(ctx context.Context, organizationID string) (*makeapi.AuditLogExport, error)
+	SetAuditLogExport(ctx context.Context, organizationID string, export makeapi.AuditLogExport) (*makeapi.AuditLogExport, error)
+	DeleteAuditLogExport(ctx context.Context, organizationID string) error
+
+	GetNotificationPreferences(ctx context.Context, teamID string) (*makeapi.NotificationPreferences, error)
+	UpdateNotificationPreferences(ctx context.Context, teamID string, preferences makeapi.NotificationPreferences) (*makeapi.NotificationPreferences, error)
+
+	ListApps(ctx context.Context) ([]makeapi.AppResponse, error)
+	ListAppModules(ctx context.Context, appName string, appVersion int64) ([]makeapi.AppModuleResponse, error)
+
+	GetCurrentUser(ctx context.Context) (*makeapi.UserResponse, error)
+	GetCurrentAuthorization(ctx context.Context) (*makeapi.AuthorizationResponse, error)
+
+	CreateAPIToken(ctx context.Context, req makeapi.APITokenRequest) (*makeapi.APITokenResponse, error)
+	GetAPIToken(ctx context.Context, id string) (*makeapi.APITokenResponse, error)
+	DeleteAPIToken(ctx context.Context, id string) error
+
+	ListUserRoles(ctx context.Context) ([]makeapi.UserRoleResponse, error)
+
+	// Zone and BaseURL describe the API the client talks to
+	Zone() string
+	BaseURL() string
+
+	// MaxWorkers bounds the requests a single call fans out to at once
+	MaxWorkers() int
+
+	// Plan-time checks and diagnostics, which handle a nil *apiClient while
+	// the provider is not configured
+	PlanDefaultIDs(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, ids ...defaultID)
+	CheckReferences(ctx context.Context, plan attributeGetter, diags *diag.Diagnostics, refs ...reference)
+	CheckConnectionParameters(ctx context.Context, plan attributeGetter, diags *diag.Diagnostics)
+	AddQuotaWarning(diags *diag.Diagnostics)
+
+	// ResolveImportName resolves import IDs given by name
+	ResolveImportName(ctx context.Context, id, kind string, list importLister) (string, error)
+}
+
+// apiClient is the MakeAPI of the configured provider: the Make API client of
+// the makeapi package with the plan-time checks and diagnostics of resources
+type apiClient struct {
+	*makeapi.Client
+}
+
+var _ MakeAPI = (*apiClient)(nil)
+
+// unconfiguredClient is the client of resources while the provider is not
+// configured yet, e.g. when planning with unknown provider attributes. Its
+// plan-time methods then leave the plan to the configuration.
+var unconfiguredClient MakeAPI = (*apiClient)(nil)
+
+// AddQuotaWarning adds a warning to diags when the API quota of the
+// organization is nearly exhausted, so that platform teams notice before
+// throttling fails applies. The warning is added only once per run.
+func (c *apiClient) AddQuotaWarning(diags *diag.Diagnostics) {
+	if c == nil {
+		return
+	}
+
+	quota, ok := c.Quota.Warning()
+	if !ok {
+		return
+	}
+
+	reset := ""
+	if !quota.Reset.IsZero() {
+		reset = fmt.Sprintf(" The quota resets at %s.", quota.Reset.UTC().Format(time.RFC3339))
+	}
+
+	diags.AddWarning(
+		"Make API Quota Nearly Exhausted",
+		fmt.Sprintf("Only %d of %d API requests remain in the current rate limit window of the organization.%s "+
+			"Further requests will be throttled and may fail once the quota is used up. Consider lowering "+
+			"max_concurrent_requests, spreading applies over time or raising the API limits of the Make plan.",
+			quota.Remaining, quota.Limit, reset),
+	)
+}
@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &APITokenResource{}
+var _ resource.ResourceWithUpgradeState = &APITokenResource{}
+
+func NewAPITokenResource() resource.Resource {
+	return &APITokenResource{}
+}
+
+// APITokenResource defines the resource implementation.
+type APITokenResource struct {
+	client MakeAPI
+}
+
+// APITokenResourceModel describes the resource data model.
+type APITokenResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Label     types.String `tfsdk:"label"`
+	Scopes    types.List   `tfsdk:"scopes"`
+	Keepers   types.Map    `tfsdk:"keepers"`
+	Token     types.String `tfsdk:"token"`
+	CreatedAt types.String `tfsdk:"created_at"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *APITokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *APITokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Make.com API token of the user the provider authenticates as. The secret is only " +
+			"returned when the token is created and is stored in state as a sensitive value. API tokens cannot be " +
+			"edited: any change creates a new token and revokes the previous one.",
+
+		Version: 0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "API token identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Label of the API token",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: nameValidators(),
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes granted to the API token, e.g. `scenarios:read`",
+				Required:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values whose change rotates the API token, e.g. a rotation date",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Secret value of the API token",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation time of the API token",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *APITokenResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *APITokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *APITokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_api_token", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data APITokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Prepare the API request
+	apiReq := makeapi.APITokenRequest{
+		Label: data.Label.ValueString(),
+	}
+
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &apiReq.Scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the API token via API
+	token, err := r.client.CreateAPIToken(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API token, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state. The secret is never returned again.
+	data.Id = types.StringValue(token.ID.String())
+	data.Token = types.StringValue(token.Token)
+	data.CreatedAt = types.StringValue(token.CreatedAt)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created an API token resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APITokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_api_token", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data APITokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the API token from the API
+	token, err := r.client.GetAPIToken(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token, got error: %s", err))
+		return
+	}
+
+	// Map API response to Terraform state, keeping the secret from state
+	data.Label = types.StringValue(token.Label)
+	data.Scopes = stringListValueLike(ctx, data.Scopes, token.Scopes)
+
+	if token.CreatedAt != "" {
+		data.CreatedAt = types.StringValue(token.CreatedAt)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APITokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer recoverPanic(ctx, "make_api_token", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data APITokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Every configurable attribute requires replacement, so there is nothing
+	// to send to the API
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APITokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_api_token", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data APITokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Revoke the API token via API
+	err := r.client.DeleteAPIToken(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete API token, got error: %s", err))
+		return
+	}
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AppModulesDataSource{}
+
+func NewAppModulesDataSource() datasource.DataSource {
+	return &AppModulesDataSource{}
+}
+
+// AppModulesDataSource defines the data source implementation.
+type AppModulesDataSource struct {
+	client MakeAPI
+}
+
+// AppModulesDataSourceModel describes the data source data model.
+type AppModulesDataSourceModel struct {
+	AppName    types.String `tfsdk:"app_name"`
+	AppVersion types.Int64  `tfsdk:"app_version"`
+	Type       types.String `tfsdk:"type"`
+	Modules    types.List   `tfsdk:"modules"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// appModuleAttrTypes are the attribute types of a module in the modules list
+var appModuleAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"label":       types.StringType,
+	"type":        types.StringType,
+	"description": types.StringType,
+	"parameters":  types.StringType,
+	"interface":   types.StringType,
+}
+
+func (d *AppModulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_modules"
+}
+
+func (d *AppModulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Modules of a Make.com app with their parameter schemas, e.g. to generate scenario " +
+			"blueprints programmatically",
+
+		Attributes: map[string]schema.Attribute{
+			"app_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the app, e.g. `google-sheets`",
+				Required:            true,
+			},
+			"app_version": schema.Int64Attribute{
+				MarkdownDescription: "Major version of the app. Defaults to `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return modules of this type: `trigger`, `instant_trigger`, `action`, " +
+					"`search`, `responder` or `universal`",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("trigger", "instant_trigger", "action", "search", "responder", "universal"),
+				},
+			},
+			"modules": schema.ListNestedAttribute{
+				MarkdownDescription: "Modules of the app, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the module as used in blueprints, e.g. `addRow`",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Display name of the module",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the module",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the module",
+							Computed:            true,
+						},
+						"parameters": schema.StringAttribute{
+							MarkdownDescription: "Parameter schema of the module as a JSON string",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Output schema of the module as a JSON string",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *AppModulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AppModulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_app_modules", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data AppModulesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	version := int64(1)
+	if !data.AppVersion.IsNull() {
+		version = data.AppVersion.ValueInt64()
+	}
+
+	modules, err := d.client.ListAppModules(ctx, data.AppName.ValueString(), version)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list app modules, got error: %s", err))
+		return
+	}
+
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Name < modules[j].Name
+	})
+
+	// Map response to Terraform state
+	moduleValues := make([]attr.Value, 0, len(modules))
+	for _, module := range modules {
+		if !data.Type.IsNull() && module.Type != data.Type.ValueString() {
+			continue
+		}
+
+		parameters := types.StringNull()
+		if len(module.Parameters) > 0 {
+			parameters = types.StringValue(string(module.Parameters))
+		}
+
+		moduleInterface := types.StringNull()
+		if len(module.Interface) > 0 {
+			moduleInterface = types.StringValue(string(module.Interface))
+		}
+
+		moduleValues = append(moduleValues, types.ObjectValueMust(appModuleAttrTypes, map[string]attr.Value{
+			"name":        types.StringValue(module.Name),
+			"label":       types.StringValue(module.Label),
+			"type":        types.StringValue(module.Type),
+			"description": optionalString(module.Description),
+			"parameters":  parameters,
+			"interface":   moduleInterface,
+		}))
+	}
+
+	data.Modules = types.ListValueMust(types.ObjectType{AttrTypes: appModuleAttrTypes}, moduleValues)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read an app modules data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AppsDataSource{}
+
+func NewAppsDataSource() datasource.DataSource {
+	return &AppsDataSource{}
+}
+
+// AppsDataSource defines the data source implementation.
+type AppsDataSource struct {
+	client MakeAPI
+}
+
+// AppsDataSourceModel describes the data source data model.
+type AppsDataSourceModel struct {
+	IncludeBeta types.Bool `tfsdk:"include_beta"`
+	Apps        types.List `tfsdk:"apps"`
+	Names       types.Set  `tfsdk:"names"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// appAttrTypes are the attribute types of an app in the apps list
+var appAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"label":   types.StringType,
+	"version": types.Int64Type,
+	"beta":    types.BoolType,
+}
+
+func (d *AppsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apps"
+}
+
+func (d *AppsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Apps available in Make.com, e.g. to check that the `app_name` of a connection exists " +
+			"before applying",
+
+		Attributes: map[string]schema.Attribute{
+			"include_beta": schema.BoolAttribute{
+				MarkdownDescription: "Whether to return apps in beta. Defaults to `true`.",
+				Optional:            true,
+			},
+			"apps": schema.ListNestedAttribute{
+				MarkdownDescription: "Available apps, sorted by name and version",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the app as used in `app_name`, e.g. `google-sheets`",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Display name of the app",
+							Computed:            true,
+						},
+						"version": schema.Int64Attribute{
+							MarkdownDescription: "Major version of the app",
+							Computed:            true,
+						},
+						"beta": schema.BoolAttribute{
+							MarkdownDescription: "Whether the app is in beta",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"names": schema.SetAttribute{
+				MarkdownDescription: "Names of the available apps",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *AppsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_apps", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data AppsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	apps, err := d.client.ListApps(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list apps, got error: %s", err))
+		return
+	}
+
+	sort.Slice(apps, func(i, j int) bool {
+		if apps[i].Name != apps[j].Name {
+			return apps[i].Name < apps[j].Name
+		}
+		return apps[i].Version < apps[j].Version
+	})
+
+	// Map response to Terraform state
+	includeBeta := data.IncludeBeta.IsNull() || data.IncludeBeta.ValueBool()
+	appValues := make([]attr.Value, 0, len(apps))
+	names := make([]attr.Value, 0, len(apps))
+	lastName := ""
+	for _, app := range apps {
+		if app.Beta && !includeBeta {
+			continue
+		}
+
+		appValues = append(appValues, types.ObjectValueMust(appAttrTypes, map[string]attr.Value{
+			"name":    types.StringValue(app.Name),
+			"label":   types.StringValue(app.Label),
+			"version": types.Int64Value(app.Version),
+			"beta":    types.BoolValue(app.Beta),
+		}))
+
+		// Apps with several versions are only named once
+		if app.Name != lastName {
+			names = append(names, types.StringValue(app.Name))
+			lastName = app.Name
+		}
+	}
+
+	data.Apps = types.ListValueMust(types.ObjectType{AttrTypes: appAttrTypes}, appValues)
+	data.Names = types.SetValueMust(types.StringType, names)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read an apps data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
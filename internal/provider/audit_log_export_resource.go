@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AuditLogExportResource{}
+var _ resource.ResourceWithImportState = &AuditLogExportResource{}
+var _ resource.ResourceWithConfigValidators = &AuditLogExportResource{}
+var _ resource.ResourceWithModifyPlan = &AuditLogExportResource{}
+var _ resource.ResourceWithUpgradeState = &AuditLogExportResource{}
+var _ resource.ResourceWithIdentity = &AuditLogExportResource{}
+
+// auditLogExportURLPattern matches the HTTPS URLs audit logs can be exported to
+var auditLogExportURLPattern = regexp.MustCompile(`^https://`)
+
+// Audit log export destination types
+const (
+	auditLogExportWebhook = "webhook"
+	auditLogExportSplunk  = "splunk"
+	auditLogExportDatadog = "datadog"
+)
+
+func NewAuditLogExportResource() resource.Resource {
+	return &AuditLogExportResource{}
+}
+
+// AuditLogExportResource defines the resource implementation.
+type AuditLogExportResource struct {
+	client MakeAPI
+}
+
+// AuditLogExportResourceModel describes the resource data model.
+type AuditLogExportResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	OrganizationId  types.String `tfsdk:"organization_id"`
+	DestinationType types.String `tfsdk:"destination_type"`
+	Url             types.String `tfsdk:"url"`
+	Token           types.String `tfsdk:"token"`
+	Headers         types.Map    `tfsdk:"headers"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *AuditLogExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log_export"
+}
+
+func (r *AuditLogExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Export of the audit log of a Make.com organization to a webhook or SIEM. An " +
+			"organization has a single export destination. Make.com never returns the token and headers, so " +
+			"changes made to them outside of Terraform cannot be detected.",
+
+		Version: 0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the export, the same as `organization_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization whose audit log is exported. Defaults to the provider `default_organization_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the destination: `webhook`, `splunk` (HTTP Event Collector) or `datadog`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(auditLogExportWebhook, auditLogExportSplunk, auditLogExportDatadog),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "HTTPS URL audit log entries are sent to",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(auditLogExportURLPattern, "must be an HTTPS URL"),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Token authenticating to the destination, e.g. the HEC token for Splunk or the API key for Datadog",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with each request, e.g. for webhook authentication",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether audit log entries are exported. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *AuditLogExportResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *AuditLogExportResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization_id": identityschema.StringAttribute{
+				Description:       "ID of the organization whose audit log is exported",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *AuditLogExportResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(path.Root("organization_id")),
+	}
+}
+
+func (r *AuditLogExportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.PlanDefaultIDs(ctx, req, resp, defaultID{Path: path.Root("organization_id"), Organization: true, Required: true})
+
+	r.client.CheckReferences(ctx, resp.Plan, &resp.Diagnostics,
+		reference{Path: path.Root("organization_id"), Endpoint: "v2/organizations/%s"},
+	)
+}
+
+func (r *AuditLogExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		r.client = unconfiguredClient
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AuditLogExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_audit_log_export", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data AuditLogExportResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
+
+	export := makeapi.AuditLogExport{
+		DestinationType: data.DestinationType.ValueString(),
+		URL:             data.Url.ValueString(),
+		Token:           data.Token.ValueString(),
+		Enabled:         data.Enabled.ValueBool(),
+	}
+
+	if !data.Headers.IsNull() {
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &export.Headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Configure the export via API
+	_, err := r.client.SetAuditLogExport(ctx, data.OrganizationId.ValueString(), export)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create audit log export, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.Id = data.OrganizationId
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created an audit log export resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuditLogExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_audit_log_export", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data AuditLogExportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the export from the API
+	export, err := r.client.GetAuditLogExport(ctx, data.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read audit log export, got error: %s", err))
+		return
+	}
+
+	// Map API response to Terraform state. The token and headers are kept
+	// from state as they are never returned.
+	data.DestinationType = types.StringValue(export.DestinationType)
+	data.Url = types.StringValue(export.URL)
+	data.Enabled = types.BoolValue(export.Enabled)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuditLogExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer recoverPanic(ctx, "make_audit_log_export", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data AuditLogExportResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	export := makeapi.AuditLogExport{
+		DestinationType: data.DestinationType.ValueString(),
+		URL:             data.Url.ValueString(),
+		Token:           data.Token.ValueString(),
+		Enabled:         data.Enabled.ValueBool(),
+	}
+
+	if !data.Headers.IsNull() {
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &export.Headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Replace the export via API
+	_, err := r.client.SetAuditLogExport(ctx, data.OrganizationId.ValueString(), export)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update audit log export, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuditLogExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_audit_log_export", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data AuditLogExportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Stop the export via API
+	err := r.client.DeleteAuditLogExport(ctx, data.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete audit log export, got error: %s", err))
+		return
+	}
+}
+
+func (r *AuditLogExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "organization_id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The export is imported by organization ID
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+}
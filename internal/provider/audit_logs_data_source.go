@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuditLogsDataSource{}
+
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &AuditLogsDataSource{}
+}
+
+// AuditLogsDataSource defines the data source implementation.
+type AuditLogsDataSource struct {
+	client MakeAPI
+}
+
+// AuditLogsDataSourceModel describes the data source data model.
+type AuditLogsDataSourceModel struct {
+	OrganizationId types.String `tfsdk:"organization_id"`
+	ActorId        types.String `tfsdk:"actor_id"`
+	Action         types.String `tfsdk:"action"`
+	EntityType     types.String `tfsdk:"entity_type"`
+	EntityId       types.String `tfsdk:"entity_id"`
+	From           types.String `tfsdk:"from"`
+	To             types.String `tfsdk:"to"`
+	Limit          types.Int64  `tfsdk:"limit"`
+	Entries        types.List   `tfsdk:"entries"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// auditLogEntryAttrTypes are the attribute types of an entry in the entries list
+var auditLogEntryAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"actor_id":    types.StringType,
+	"actor_name":  types.StringType,
+	"action":      types.StringType,
+	"entity_type": types.StringType,
+	"entity_id":   types.StringType,
+	"entity_name": types.StringType,
+	"team_id":     types.StringType,
+	"created_at":  types.StringType,
+}
+
+func (d *AuditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+func (d *AuditLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Audit log entries of a Make.com organization, e.g. to feed compliance reports. All " +
+			"pages matching the filters are read unless `limit` is set, so narrow the time range for busy organizations.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization whose audit log is read",
+				Required:            true,
+			},
+			"actor_id": schema.StringAttribute{
+				MarkdownDescription: "Only return entries of actions performed by this user",
+				Optional:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Only return entries of this action, e.g. `scenario_updated`",
+				Optional:            true,
+			},
+			"entity_type": schema.StringAttribute{
+				MarkdownDescription: "Only return entries about this type of entity, e.g. `scenario` or `connection`",
+				Optional:            true,
+			},
+			"entity_id": schema.StringAttribute{
+				MarkdownDescription: "Only return entries about the entity with this ID",
+				Optional:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Only return entries created at or after this RFC 3339 timestamp",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "Only return entries created before this RFC 3339 timestamp",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of entries to return, taking the newest entries first",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "Audit log entries, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Audit log entry identifier",
+							Computed:            true,
+						},
+						"actor_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the user who performed the action",
+							Computed:            true,
+						},
+						"actor_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the user who performed the action",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Action that was performed",
+							Computed:            true,
+						},
+						"entity_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the entity the action was performed on",
+							Computed:            true,
+						},
+						"entity_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the entity the action was performed on",
+							Computed:            true,
+						},
+						"entity_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the entity at the time of the action",
+							Computed:            true,
+						},
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the team the entity belongs to, if any",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Time the action was performed",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *AuditLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_audit_logs", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data AuditLogsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	filter := makeapi.AuditLogFilter{
+		ActorID:    data.ActorId.ValueString(),
+		Action:     data.Action.ValueString(),
+		EntityType: data.EntityType.ValueString(),
+		EntityID:   data.EntityId.ValueString(),
+		From:       data.From.ValueString(),
+		To:         data.To.ValueString(),
+	}
+
+	entries, err := d.client.ListAuditLogs(ctx, data.OrganizationId.ValueString(), filter, int(data.Limit.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read audit logs, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	entryValues := make([]attr.Value, 0, len(entries))
+	for _, entry := range entries {
+		entryValues = append(entryValues, types.ObjectValueMust(auditLogEntryAttrTypes, map[string]attr.Value{
+			"id":          types.StringValue(entry.ID.String()),
+			"actor_id":    types.StringValue(entry.ActorID.String()),
+			"actor_name":  types.StringValue(entry.ActorName),
+			"action":      types.StringValue(entry.Action),
+			"entity_type": types.StringValue(entry.EntityType),
+			"entity_id":   types.StringValue(entry.EntityID.String()),
+			"entity_name": types.StringValue(entry.EntityName),
+			"team_id":     optionalString(entry.TeamID.String()),
+			"created_at":  types.StringValue(entry.CreatedAt),
+		}))
+	}
+
+	data.Entries = types.ListValueMust(types.ObjectType{AttrTypes: auditLogEntryAttrTypes}, entryValues)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read an audit logs data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
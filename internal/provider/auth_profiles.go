@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// authProfilePattern matches the names of auth profiles
+var authProfilePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// authProfileDescription documents the auth_profile attribute shared by all
+// resources and data sources
+const authProfileDescription = "Name of an entry of the provider `auth_profiles` whose credentials are used for " +
+	"this object, e.g. to manage several Make organizations from one configuration. Defaults to the " +
+	"credentials of the provider."
+
+// withAuthProfile returns a context whose API requests are sent with the
+// credentials of the given auth profile. Null or unknown profiles leave the
+// default credentials of the provider in place.
+func withAuthProfile(ctx context.Context, profile types.String) context.Context {
+	if profile.IsNull() || profile.IsUnknown() {
+		return ctx
+	}
+
+	return makeapi.WithAuthProfile(ctx, profile.ValueString())
+}
+
+func authProfileValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(authProfilePattern, "must be the name of an auth profile"),
+	}
+}
+
+// authProfileResourceAttribute returns the auth_profile attribute of resources
+func authProfileResourceAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		MarkdownDescription: authProfileDescription,
+		Optional:            true,
+		Validators:          authProfileValidators(),
+	}
+}
+
+// authProfileDataSourceAttribute returns the auth_profile attribute of data
+// sources
+func authProfileDataSourceAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		MarkdownDescription: authProfileDescription,
+		Optional:            true,
+		Validators:          authProfileValidators(),
+	}
+}
+
+// authProfileEphemeralAttribute returns the auth_profile attribute of
+// ephemeral resources
+func authProfileEphemeralAttribute() ephemeralschema.StringAttribute {
+	return ephemeralschema.StringAttribute{
+		MarkdownDescription: authProfileDescription,
+		Optional:            true,
+		Validators:          authProfileValidators(),
+	}
+}
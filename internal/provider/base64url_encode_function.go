@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &Base64URLEncodeFunction{}
+
+func NewBase64URLEncodeFunction() function.Function {
+	return &Base64URLEncodeFunction{}
+}
+
+// Base64URLEncodeFunction defines the function implementation.
+type Base64URLEncodeFunction struct{}
+
+func (f *Base64URLEncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "base64url_encode"
+}
+
+func (f *Base64URLEncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Encode a string using URL-safe base64",
+		MarkdownDescription: "Encodes the given string using the URL-safe base64 alphabet from RFC 4648 without padding, " +
+			"so the result can be embedded in webhook URLs, query strings and blueprint payloads without further escaping.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "input",
+				MarkdownDescription: "String to encode",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Base64URLEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, base64.RawURLEncoding.EncodeToString([]byte(input))))
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// cassetteDir holds the recorded API interactions of acceptance tests, one
+// file per test
+const cassetteDir = "testdata/cassettes"
+
+// redactedValue replaces secrets in recorded interactions, and is the API
+// token tests replaying a cassette are configured with
+const redactedValue = makeapi.RedactedValue
+
+// redactedEnv are the environment variables whose values are replaced
+// wherever they appear in recorded interactions
+var redactedEnv = []string{"MAKE_API_TOKEN", "MAKE_OAUTH_CLIENT_SECRET"}
+
+// cassette is the recording of the API interactions of an acceptance test,
+// which is replayed when the test runs with MAKE_TEST_REPLAY
+type cassette struct {
+	// BaseURL and APIPathPrefix are the API the cassette was recorded
+	// against, which the provider is configured with when replaying
+	BaseURL       string `json:"base_url"`
+	APIPathPrefix string `json:"api_path_prefix,omitempty"`
+
+	// Env holds the MAKE_TEST_* variables set while recording, e.g. the IDs
+	// of existing objects the test refers to
+	Env map[string]string `json:"env,omitempty"`
+
+	Interactions []*interaction `json:"interactions"`
+}
+
+// interaction is a recorded request and its response
+type interaction struct {
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	RequestBody  string      `json:"request_body,omitempty"`
+	Status       int         `json:"status"`
+	Header       http.Header `json:"header,omitempty"`
+	ResponseBody string      `json:"response_body,omitempty"`
+
+	// used marks interactions already replayed, so that repeated requests
+	// get their responses in recorded order
+	used bool
+}
+
+// cassetteTransport records the API interactions of an acceptance test into a
+// cassette, or replays them from one without contacting the API
+type cassetteTransport struct {
+	mu        sync.Mutex
+	cassette  cassette
+	recording bool
+}
+
+// newCassetteRecorder returns a transport recording the interactions with
+// the API the provider is configured for by the environment
+func newCassetteRecorder() *cassetteTransport {
+	baseURL := os.Getenv("MAKE_BASE_URL")
+	if zone := os.Getenv("MAKE_ZONE"); baseURL == "" && zone != "" {
+		baseURL = zoneBaseURL(zone)
+	}
+	if baseURL == "" {
+		baseURL = "https://api.make.com/"
+	}
+
+	env := make(map[string]string)
+	for _, variable := range os.Environ() {
+		name, value, _ := strings.Cut(variable, "=")
+		if strings.HasPrefix(name, "MAKE_TEST_") && name != "MAKE_TEST_RECORD" && name != "MAKE_TEST_REPLAY" {
+			env[name] = value
+		}
+	}
+
+	return &cassetteTransport{
+		cassette: cassette{
+			BaseURL:       baseURL,
+			APIPathPrefix: os.Getenv("MAKE_API_PATH_PREFIX"),
+			Env:           env,
+		},
+		recording: true,
+	}
+}
+
+// cassettePath returns the file of the cassette of a test
+func cassettePath(testName string) string {
+	return filepath.Join(cassetteDir, strings.ReplaceAll(testName, "/", "_")+".json")
+}
+
+// loadCassette returns a transport replaying the cassette of a test, nil if
+// none was recorded
+func loadCassette(testName string) (*cassetteTransport, error) {
+	data, err := os.ReadFile(cassettePath(testName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := &cassetteTransport{}
+	if err := json.Unmarshal(data, &c.cassette); err != nil {
+		return nil, fmt.Errorf("invalid cassette %s: %w", cassettePath(testName), err)
+	}
+
+	return c, nil
+}
+
+// save writes the recorded interactions to the cassette of a test
+func (c *cassetteTransport) save(testName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cassetteDir, 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(cassettePath(testName), append(data, '\n'), 0o644)
+}
+
+// wrap returns the transport recording or replaying through the cassette. A
+// nil cassette leaves the transport as it is.
+func (c *cassetteTransport) wrap(next http.RoundTripper) http.RoundTripper {
+	if c == nil {
+		return next
+	}
+
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if c.recording {
+			return c.record(next, req)
+		}
+
+		return c.replay(req)
+	})
+}
+
+// record performs a request and records it with its response. Conditional
+// GETs are made unconditional, so that every recorded response carries its
+// body whatever the response cache holds when replaying.
+func (c *cassetteTransport) record(next http.RoundTripper, req *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Del("If-None-Match")
+	req.Body = io.NopCloser(bytes.NewReader(requestBody))
+
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cassette.Interactions = append(c.cassette.Interactions, &interaction{
+		Method:       req.Method,
+		URL:          req.URL.RequestURI(),
+		RequestBody:  redact(req.URL.Path, requestBody),
+		Status:       resp.StatusCode,
+		Header:       header,
+		ResponseBody: redact(req.URL.Path, responseBody),
+	})
+
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction recorded for
+// the same request
+func (c *cassetteTransport) replay(req *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	body := redact(req.URL.Path, requestBody)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, recorded := range c.cassette.Interactions {
+		if recorded.used || recorded.Method != req.Method || recorded.URL != req.URL.RequestURI() || recorded.RequestBody != body {
+			continue
+		}
+		recorded.used = true
+
+		header := recorded.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+			StatusCode:    recorded.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(recorded.ResponseBody)),
+			ContentLength: int64(len(recorded.ResponseBody)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction matches %s %s, record the cassette again with MAKE_TEST_RECORD=1", req.Method, req.URL.RequestURI())
+}
+
+// readRequestBody reads the body of a request and restores it for sending
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// redact removes secrets from a body recorded for the endpoint at a URL
+// path: the values of sensitive fields in JSON, redacted like the debug_http
+// logs of the API client, and the credentials of the environment anywhere
+func redact(urlPath string, body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err == nil {
+		if data, err := json.Marshal(makeapi.RedactJSON(urlPath, value)); err == nil {
+			body = data
+		}
+	}
+
+	redacted := string(body)
+	for _, name := range redactedEnv {
+		if secret := os.Getenv(name); secret != "" {
+			redacted = strings.ReplaceAll(redacted, secret, redactedValue)
+		}
+	}
+
+	return redacted
+}
+
+// roundTripperFunc adapts a function to an http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
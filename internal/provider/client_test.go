@@ -2,44 +2,36 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
 )
 
-func TestMakeAPIClient_MakeRequest(t *testing.T) {
-	client := &MakeAPIClient{
-		ApiToken: "test-token",
-		BaseUrl:  "https://api.make.com/",
-	}
-
-	// Test URL construction
-	ctx := context.Background()
-
-	// This would normally hit the API, but we can test URL construction
-	req := ScenarioRequest{
-		Name:   "Test Scenario",
-		Active: true,
-	}
-
-	// Basic test that client can construct requests
-	if client.ApiToken != "test-token" {
-		t.Errorf("Expected ApiToken to be 'test-token', got %s", client.ApiToken)
-	}
-
-	if client.BaseUrl != "https://api.make.com/" {
-		t.Errorf("Expected BaseUrl to be 'https://api.make.com/', got %s", client.BaseUrl)
-	}
-
-	// Test that request structure is correct
-	if req.Name != "Test Scenario" {
-		t.Errorf("Expected Name to be 'Test Scenario', got %s", req.Name)
-	}
-
-	// Suppress unused variable warning
-	_ = ctx
-}
-
 func TestScenarioResourceModel(t *testing.T) {
 	model := ScenarioResourceModel{
 		Id:          types.StringValue("test-id"),
@@ -106,6 +98,41 @@ func TestWebhookResourceModel(t *testing.T) {
 	}
 }
 
+func TestWebhookQueueModelLike(t *testing.T) {
+	configured := &WebhookQueueModel{MaxSize: types.Int64Value(100), StopOnError: types.BoolValue(true), StoreResults: types.BoolValue(false)}
+
+	testCases := map[string]struct {
+		prior *WebhookQueueModel
+		queue *makeapi.WebhookQueue
+		want  *WebhookQueueModel
+	}{
+		"no queue":                {prior: configured},
+		"defaults not configured": {queue: &makeapi.WebhookQueue{MaxSize: 50}},
+		"configured":              {prior: configured, queue: &makeapi.WebhookQueue{MaxSize: 100, StopOnError: true}, want: configured},
+		"max size not configured": {
+			prior: &WebhookQueueModel{MaxSize: types.Int64Null(), StopOnError: types.BoolValue(true), StoreResults: types.BoolValue(false)},
+			queue: &makeapi.WebhookQueue{MaxSize: 50, StopOnError: true},
+			want:  &WebhookQueueModel{MaxSize: types.Int64Null(), StopOnError: types.BoolValue(true), StoreResults: types.BoolValue(false)},
+		},
+		"changed in Make": {
+			queue: &makeapi.WebhookQueue{MaxSize: 50, StoreResults: true},
+			want:  &WebhookQueueModel{MaxSize: types.Int64Null(), StopOnError: types.BoolValue(false), StoreResults: types.BoolValue(true)},
+		},
+	}
+
+	for name, testCase := range testCases {
+		if got := webhookQueueModelLike(testCase.prior, testCase.queue); !webhookQueueEqual(got, testCase.want) {
+			t.Errorf("%s: expected %+v, got %+v", name, testCase.want, got)
+		}
+	}
+
+	// The configured queue is sent as is, without a limit when max_size is unset
+	queue := webhookQueueRequest(&WebhookQueueModel{MaxSize: types.Int64Null(), StopOnError: types.BoolValue(true), StoreResults: types.BoolValue(true)})
+	if *queue != (makeapi.WebhookQueue{StopOnError: true, StoreResults: true}) {
+		t.Errorf("Expected a queue without limit stopping on errors and storing results, got %+v", queue)
+	}
+}
+
 func TestDataStoreResourceModel(t *testing.T) {
 	model := DataStoreResourceModel{
 		Id:          types.StringValue("ds-123"),
@@ -217,3 +244,1996 @@ func TestConvertSettingsToStringMapFloatPrecision(t *testing.T) {
 		}
 	}
 }
+
+func TestSortedStringListValue(t *testing.T) {
+	input := []string{"203.0.113.10", "10.0.0.1", "192.168.1.0/24"}
+
+	result := sortedStringListValue(input)
+
+	expected := []string{"10.0.0.1", "192.168.1.0/24", "203.0.113.10"}
+	elements := result.Elements()
+	if len(elements) != len(expected) {
+		t.Fatalf("Expected %d elements, got %d", len(expected), len(elements))
+	}
+
+	for i, want := range expected {
+		got := elements[i].(types.String).ValueString()
+		if got != want {
+			t.Errorf("Expected element %d to be '%s', got '%s'", i, want, got)
+		}
+	}
+
+	// The input slice must not be reordered in place
+	if input[0] != "203.0.113.10" {
+		t.Errorf("Expected input to be left unsorted, got %v", input)
+	}
+
+	// Ordering of the input must not affect the result
+	reversed := sortedStringListValue([]string{"192.168.1.0/24", "203.0.113.10", "10.0.0.1"})
+	if !reversed.Equal(result) {
+		t.Errorf("Expected lists built from different orderings to be equal, got %v and %v", reversed, result)
+	}
+}
+
+func TestSortedStringListValueNull(t *testing.T) {
+	if !sortedStringListValue(nil).IsNull() {
+		t.Errorf("Expected nil slice to produce a null list")
+	}
+
+	empty := sortedStringListValue([]string{})
+	if empty.IsNull() || len(empty.Elements()) != 0 {
+		t.Errorf("Expected empty slice to produce an empty, non-null list, got %v", empty)
+	}
+}
+
+func TestRecoverPanic(t *testing.T) {
+	var diags diag.Diagnostics
+
+	func() {
+		defer recoverPanic(context.Background(), "make_scenario", "read", nil, &diags)
+		panic("malformed API response")
+	}()
+
+	if !diags.HasError() {
+		t.Fatalf("Expected the panic to be converted into an error diagnostic")
+	}
+
+	detail := diags.Errors()[0].Detail()
+	for _, want := range []string{"read of make_scenario", "malformed API response", "goroutine"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("Expected diagnostic detail to contain %q, got: %s", want, detail)
+		}
+	}
+}
+
+func TestRecoverPanicWithoutPanic(t *testing.T) {
+	var diags diag.Diagnostics
+
+	func() {
+		defer recoverPanic(context.Background(), "make_scenario", "read", nil, &diags)
+	}()
+
+	if diags.HasError() {
+		t.Errorf("Expected no diagnostics when nothing panicked, got: %v", diags)
+	}
+}
+
+func TestJSONEqual(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want bool
+	}{
+		{`{"flow":[{"id":1}],"name":"x"}`, `{ "name": "x", "flow": [ { "id": 1 } ] }`, true},
+		{`{"flow":[{"id":1},{"id":2}]}`, `{"flow":[{"id":2},{"id":1}]}`, false},
+		{`{"name":"x"}`, `{"name":"y"}`, false},
+		{`not json`, `not json`, true},
+		{`not json`, `{}`, false},
+	}
+
+	for _, tc := range testCases {
+		if got := jsonEqual(tc.a, tc.b); got != tc.want {
+			t.Errorf("jsonEqual(%s, %s) = %t, expected %t", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestCustomVariableValue(t *testing.T) {
+	testCases := []struct {
+		variableType string
+		value        string
+		want         interface{}
+		wantErr      bool
+	}{
+		{makeapi.CustomVariableTypeString, "hello", "hello", false},
+		{makeapi.CustomVariableTypeDate, "2024-01-31", "2024-01-31", false},
+		{makeapi.CustomVariableTypeNumber, "1.50", 1.5, false},
+		{makeapi.CustomVariableTypeNumber, "abc", nil, true},
+		{makeapi.CustomVariableTypeBoolean, "true", true, false},
+		{makeapi.CustomVariableTypeBoolean, "yes", nil, true},
+	}
+
+	for _, tc := range testCases {
+		got, err := customVariableValue(tc.variableType, tc.value)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("customVariableValue(%s, %q) error = %v, expected error: %t", tc.variableType, tc.value, err, tc.wantErr)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("customVariableValue(%s, %q) = %v, expected %v", tc.variableType, tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestIsPlausibleReferenceID(t *testing.T) {
+	testCases := map[string]bool{
+		"12345":                        true,
+		"team-123":                     true,
+		"a1b2_c3":                      true,
+		"":                             false,
+		" 12345":                       false,
+		"My Team":                      false,
+		"https://eu1.make.com/team/42": false,
+		"-123":                         false,
+	}
+
+	for id, want := range testCases {
+		if got := isPlausibleReferenceID(id); got != want {
+			t.Errorf("isPlausibleReferenceID(%q) = %t, expected %t", id, got, want)
+		}
+	}
+}
+
+func TestZoneBaseURL(t *testing.T) {
+	testCases := map[string]string{
+		"eu1":                   "https://eu1.make.com/api/",
+		"us2.make.com":          "https://us2.make.com/api/",
+		"eu1.make.celonis.com":  "https://eu1.make.celonis.com/api/",
+		"EU2":                   "https://eu2.make.com/api/",
+		"make.example.com:8443": "https://make.example.com:8443/api/",
+		"localhost:8080":        "https://localhost:8080/api/",
+	}
+
+	for zone, want := range testCases {
+		if got := zoneBaseURL(zone); got != want {
+			t.Errorf("Expected base URL of zone %s to be '%s', got '%s'", zone, want, got)
+		}
+	}
+}
+
+func TestValidateEndpoints(t *testing.T) {
+	testCases := map[string]struct {
+		zone    types.String
+		baseUrl types.String
+		valid   bool
+	}{
+		"unset":           {types.StringNull(), types.StringNull(), true},
+		"unknown":         {types.StringUnknown(), types.StringUnknown(), true},
+		"short zone":      {types.StringValue("eu1"), types.StringNull(), true},
+		"self-hosted":     {types.StringValue("make.example.com:8443"), types.StringNull(), true},
+		"zone URL":        {types.StringValue("https://eu1.make.com"), types.StringNull(), false},
+		"base URL":        {types.StringNull(), types.StringValue("https://eu1.make.com/api/"), true},
+		"plain HTTP":      {types.StringNull(), types.StringValue("http://localhost:8080/api/"), true},
+		"relative URL":    {types.StringNull(), types.StringValue("eu1.make.com/api"), false},
+		"unsupported URL": {types.StringNull(), types.StringValue("ftp://eu1.make.com/"), false},
+	}
+
+	for name, testCase := range testCases {
+		var diags diag.Diagnostics
+
+		validateZone(path.Root("zone"), testCase.zone, &diags)
+		validateBaseURL(path.Root("base_url"), testCase.baseUrl, &diags)
+
+		if diags.HasError() == testCase.valid {
+			t.Errorf("%s: expected valid=%t, got diagnostics: %v", name, testCase.valid, diags)
+		}
+	}
+}
+
+func TestQuotaWarning(t *testing.T) {
+	testCases := map[string]struct {
+		remaining    string
+		wantWarnings int
+	}{
+		"nearly exhausted": {remaining: "5", wantWarnings: 1},
+		"plenty left":      {remaining: "50", wantWarnings: 0},
+		"not reported":     {wantWarnings: 0},
+	}
+
+	for name, testCase := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if testCase.remaining != "" {
+				w.Header().Set("X-RateLimit-Limit", "100")
+				w.Header().Set("X-RateLimit-Remaining", testCase.remaining)
+			}
+		}))
+
+		client := &apiClient{&makeapi.Client{BaseUrl: server.URL, HTTPClient: server.Client(), Quota: &makeapi.QuotaMonitor{}}}
+
+		// The warning is only added once however many requests report the quota
+		var diags diag.Diagnostics
+		for range 2 {
+			resp, err := client.MakeRequest(context.Background(), "GET", "v2/teams", nil)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %s", name, err)
+			}
+			_ = resp.Body.Close()
+
+			client.AddQuotaWarning(&diags)
+		}
+		server.Close()
+
+		if diags.WarningsCount() != testCase.wantWarnings {
+			t.Errorf("%s: expected %d warnings, got %v", name, testCase.wantWarnings, diags)
+		}
+	}
+}
+
+func TestProviderUserAgent(t *testing.T) {
+	testCases := map[string]struct {
+		version          string
+		terraformVersion string
+		want             string
+	}{
+		"release":       {version: "1.4.0", terraformVersion: "1.9.5", want: "terraform-provider-make/1.4.0 (terraform-plugin-framework) Terraform/1.9.5"},
+		"no terraform":  {version: "test", want: "terraform-provider-make/test (terraform-plugin-framework)"},
+		"empty version": {want: "terraform-provider-make/dev (terraform-plugin-framework)"},
+	}
+
+	for name, testCase := range testCases {
+		if got := providerUserAgent(testCase.version, testCase.terraformVersion); got != testCase.want {
+			t.Errorf("%s: expected %q, got %q", name, testCase.want, got)
+		}
+	}
+
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.UserAgent()
+	}))
+	defer server.Close()
+
+	client := &makeapi.Client{BaseUrl: server.URL, HTTPClient: server.Client(), UserAgent: providerUserAgent("1.4.0", "")}
+
+	resp, err := client.MakeRequest(context.Background(), "GET", "v2/teams", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	_ = resp.Body.Close()
+
+	if userAgent != "terraform-provider-make/1.4.0 (terraform-plugin-framework)" {
+		t.Errorf("Expected the provider User-Agent, got %q", userAgent)
+	}
+}
+
+// testPrivateState is an in-memory private state of a resource
+type testPrivateState map[string][]byte
+
+func (s testPrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return s[key], nil
+}
+
+func (s testPrivateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(s, key)
+	} else {
+		s[key] = value
+	}
+	return nil
+}
+
+func TestObjectVersion(t *testing.T) {
+	etag := `"v1"`
+	var ifMatch []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			w.Header().Set("ETag", etag)
+			_ = json.NewEncoder(w).Encode(makeapi.DataStoreResponse{ID: "5"})
+			return
+		}
+
+		ifMatch = append(ifMatch, r.Header.Get("If-Match"))
+		if r.Header.Get("If-Match") != etag {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			_ = json.NewEncoder(w).Encode(makeapi.ErrorResponse{Message: "Precondition failed"})
+			return
+		}
+		etag = `"v2"`
+		w.Header().Set("ETag", etag)
+		_ = json.NewEncoder(w).Encode(makeapi.DataStoreResponse{ID: "5"})
+	}))
+	defer server.Close()
+
+	client := &makeapi.Client{BaseUrl: server.URL, HTTPClient: server.Client()}
+	private := testPrivateState{}
+
+	// Reading records the ETag in private state
+	ctx, etags := makeapi.WithETagRecorder(context.Background())
+	if _, err := client.GetDataStore(ctx, "5"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diags := setObjectVersion(ctx, private, objectVersion{ETag: etags.ETag()}); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	version, diags := getObjectVersion(context.Background(), private)
+	if diags.HasError() || version.ETag != `"v1"` {
+		t.Fatalf("Expected stored ETag \"v1\", got %q: %v", version.ETag, diags)
+	}
+
+	// Updating with the current ETag succeeds and records the new one
+	ctx, etags = makeapi.WithETagRecorder(makeapi.WithIfMatch(context.Background(), version.ETag))
+	if _, err := client.UpdateDataStore(ctx, "5", makeapi.DataStoreRequest{}, makeapi.DataStoreRequest{Name: "Store"}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if etags.ETag() != `"v2"` {
+		t.Errorf("Expected ETag \"v2\" after the update, got %q", etags.ETag())
+	}
+
+	// Updating with an outdated ETag is refused as a conflict
+	_, err := client.UpdateDataStore(makeapi.WithIfMatch(context.Background(), `"v1"`), "5", makeapi.DataStoreRequest{}, makeapi.DataStoreRequest{Name: "Store"})
+	var conflict *makeapi.ConflictError
+	if !errors.As(err, &conflict) {
+		t.Errorf("Expected a conflict error, got %v", err)
+	}
+
+	if strings.Join(ifMatch, " ") != `"v1" "v1"` {
+		t.Errorf("Expected If-Match headers \"v1\" \"v1\", got %v", ifMatch)
+	}
+
+	// An empty version removes the key
+	_ = setObjectVersion(context.Background(), private, objectVersion{})
+	if _, ok := private[versionPrivateKey]; ok {
+		t.Errorf("Expected the version to be removed from private state")
+	}
+}
+
+// fakeTeamAPI serves teams from memory
+type fakeTeamAPI struct {
+	MakeAPI
+	teams map[string]*makeapi.TeamResponse
+}
+
+func (f *fakeTeamAPI) GetTeam(ctx context.Context, id string) (*makeapi.TeamResponse, error) {
+	team, ok := f.teams[id]
+	if !ok {
+		return nil, &makeapi.NotFoundError{Message: "team with ID " + id + " not found"}
+	}
+
+	return team, nil
+}
+
+func (f *fakeTeamAPI) AddQuotaWarning(diags *diag.Diagnostics) {}
+
+// fakeScenarioAPI serves a scenario whose executions run for a number of polls,
+// or whose executions take until the context is done to list when slow
+type fakeScenarioAPI struct {
+	MakeAPI
+	scenario *makeapi.ScenarioResponse
+	running  int
+	stopped  bool
+	slow     bool
+}
+
+func (f *fakeScenarioAPI) GetScenario(ctx context.Context, id string) (*makeapi.ScenarioResponse, error) {
+	if f.scenario == nil {
+		return nil, &makeapi.NotFoundError{Message: "scenario with ID " + id + " not found"}
+	}
+
+	return f.scenario, nil
+}
+
+func (f *fakeScenarioAPI) StopScenario(ctx context.Context, id string) (*makeapi.ScenarioResponse, error) {
+	f.stopped = true
+	f.scenario.Active = false
+
+	return f.scenario, nil
+}
+
+func (f *fakeScenarioAPI) ListScenarioExecutions(ctx context.Context, id, status string) ([]makeapi.ScenarioExecution, error) {
+	if f.slow {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if f.running < 0 {
+		return []makeapi.ScenarioExecution{{ID: "1", Status: status}}, nil
+	}
+	if f.running == 0 {
+		return nil, nil
+	}
+
+	f.running--
+	return []makeapi.ScenarioExecution{{ID: "1", Status: status}}, nil
+}
+
+func TestStopScenario(t *testing.T) {
+	pollInterval := scenarioExecutionsPollInterval
+	scenarioExecutionsPollInterval = time.Millisecond
+	defer func() { scenarioExecutionsPollInterval = pollInterval }()
+
+	testCases := map[string]struct {
+		client      *fakeScenarioAPI
+		expected    bool
+		wantStopped bool
+		wantErr     bool
+	}{
+		"inactive": {
+			client:   &fakeScenarioAPI{scenario: &makeapi.ScenarioResponse{ID: "1"}},
+			expected: true,
+		},
+		"active": {
+			client:      &fakeScenarioAPI{scenario: &makeapi.ScenarioResponse{ID: "1", Active: true}},
+			expected:    true,
+			wantStopped: true,
+		},
+		"running executions": {
+			client:      &fakeScenarioAPI{scenario: &makeapi.ScenarioResponse{ID: "1", Active: true}, running: 3},
+			expected:    true,
+			wantStopped: true,
+		},
+		"already deleted": {
+			client: &fakeScenarioAPI{},
+		},
+		"executions never finishing": {
+			client:      &fakeScenarioAPI{scenario: &makeapi.ScenarioResponse{ID: "1", Active: true}, running: -1},
+			wantStopped: true,
+			wantErr:     true,
+		},
+		"listing executions never finishing": {
+			client:      &fakeScenarioAPI{scenario: &makeapi.ScenarioResponse{ID: "1", Active: true}, slow: true},
+			wantStopped: true,
+			wantErr:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		stopped, err := stopScenario(ctx, testCase.client, "1")
+		cancel()
+
+		if (err != nil) != testCase.wantErr {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "still has running executions") {
+			t.Errorf("%s: expected the running executions error, got: %v", name, err)
+		}
+		if stopped != testCase.expected {
+			t.Errorf("%s: expected %v, got %v", name, testCase.expected, stopped)
+		}
+		if testCase.client.stopped != testCase.wantStopped {
+			t.Errorf("%s: expected the scenario stopped to be %v", name, testCase.wantStopped)
+		}
+		if testCase.client.running > 0 {
+			t.Errorf("%s: expected to wait for all executions, %d still running", name, testCase.client.running)
+		}
+	}
+}
+
+// fakeWebhookScenariosAPI serves scenarios from memory and records the
+// changes made to them
+type fakeWebhookScenariosAPI struct {
+	MakeAPI
+	scenarios []makeapi.ScenarioResponse
+	stopped   []string
+	updated   map[string][]byte
+}
+
+func (f *fakeWebhookScenariosAPI) ListScenarios(ctx context.Context, teamID string) ([]makeapi.ScenarioResponse, error) {
+	return f.scenarios, nil
+}
+
+func (f *fakeWebhookScenariosAPI) StopScenario(ctx context.Context, id string) (*makeapi.ScenarioResponse, error) {
+	f.stopped = append(f.stopped, id)
+	return &makeapi.ScenarioResponse{ID: makeapi.ID(id)}, nil
+}
+
+func (f *fakeWebhookScenariosAPI) UpdateScenario(ctx context.Context, id string, prior, req makeapi.ScenarioRequest) (*makeapi.ScenarioResponse, error) {
+	body, _ := json.Marshal(req)
+	f.updated[id] = body
+	return &makeapi.ScenarioResponse{ID: makeapi.ID(id)}, nil
+}
+
+func TestDetachWebhook(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeWebhookScenariosAPI{
+		scenarios: []makeapi.ScenarioResponse{
+			{ID: "1", Name: "Orders", Active: true, HookID: "5"},
+			{ID: "2", Name: "Paused", HookID: "5"},
+			{ID: "3", Name: "Audit", ExportExecutionsTo: &makeapi.ScenarioExecutionExport{Type: "hook", ID: "5"}},
+			{ID: "4", Name: "Other hook", Active: true, HookID: "6"},
+			{ID: "5", Name: "Data store", ExportExecutionsTo: &makeapi.ScenarioExecutionExport{Type: "data_store", ID: "5"}},
+		},
+		updated: map[string][]byte{},
+	}
+
+	scenarios, err := webhookScenarios(ctx, client, "42", "5")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := `"Orders" (ID 1), "Paused" (ID 2), "Audit" (ID 3)`
+	if list := scenarioList(scenarios); list != expected {
+		t.Errorf("Expected the scenarios %s, got %s", expected, list)
+	}
+
+	if err := detachWebhook(ctx, client, "5", scenarios); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if strings.Join(client.stopped, ",") != "1" {
+		t.Errorf("Expected only the active scenario triggered by the webhook to be stopped, got %v", client.stopped)
+	}
+
+	if len(client.updated) != 1 || strings.Contains(string(client.updated["3"]), "export_executions_to") {
+		t.Errorf("Expected the execution logs of scenario 3 to no longer be exported, got %s", client.updated)
+	}
+}
+
+func TestWarnScenarioDeactivation(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&ScenarioResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	model := func(active types.Bool) *ScenarioResourceModel {
+		return &ScenarioResourceModel{
+			Id:       types.StringValue("1"),
+			Name:     types.StringValue("Orders"),
+			Active:   active,
+			Timeouts: nullTimeouts(schemaResp.Schema),
+		}
+	}
+
+	testCases := map[string]struct {
+		state, plan *ScenarioResourceModel
+		expected    string
+	}{
+		"deactivated": {
+			state:    model(types.BoolValue(true)),
+			plan:     model(types.BoolValue(false)),
+			expected: "Active Scenario Will Be Deactivated",
+		},
+		"active unset": {
+			state:    model(types.BoolValue(true)),
+			plan:     model(types.BoolNull()),
+			expected: "Active Scenario Will Be Deactivated",
+		},
+		"still active": {
+			state: model(types.BoolValue(true)),
+			plan:  model(types.BoolValue(true)),
+		},
+		"unknown": {
+			state: model(types.BoolValue(true)),
+			plan:  model(types.BoolUnknown()),
+		},
+		"inactive": {
+			state: model(types.BoolValue(false)),
+			plan:  model(types.BoolValue(false)),
+		},
+		"created": {
+			plan: model(types.BoolValue(false)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		if testCase.state != nil {
+			if diags := state.Set(ctx, testCase.state); diags.HasError() {
+				t.Fatalf("%s: unexpected diagnostics: %v", name, diags)
+			}
+		}
+
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		if diags := plan.Set(ctx, testCase.plan); diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", name, diags)
+		}
+
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		warnScenarioDeactivation(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+
+		warnings := resp.Diagnostics.Warnings()
+		switch {
+		case resp.Diagnostics.HasError():
+			t.Errorf("%s: unexpected diagnostics: %v", name, resp.Diagnostics)
+		case testCase.expected == "" && len(warnings) > 0:
+			t.Errorf("%s: expected no warning, got %v", name, warnings)
+		case testCase.expected != "" && (len(warnings) != 1 || warnings[0].Summary() != testCase.expected):
+			t.Errorf("%s: expected the warning %q, got %v", name, testCase.expected, warnings)
+		}
+	}
+}
+
+func TestPlanSchedulingInterval(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&ScenarioResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	model := func(scheduleType string, interval types.Int64) *ScenarioResourceModel {
+		return &ScenarioResourceModel{
+			Id:         types.StringValue("1"),
+			Name:       types.StringValue("Orders"),
+			Scheduling: &ScenarioSchedulingModel{Type: types.StringValue(scheduleType), Interval: interval},
+			Timeouts:   nullTimeouts(schemaResp.Schema),
+		}
+	}
+	raw := func(model *ScenarioResourceModel) tftypes.Value {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		if diags := plan.Set(ctx, model); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return plan.Raw
+	}
+
+	testCases := map[string]struct {
+		config, plan *ScenarioResourceModel
+		want         types.Int64
+	}{
+		"same schedule": {
+			config: model("indefinitely", types.Int64Null()),
+			plan:   model("indefinitely", types.Int64Value(900)),
+			want:   types.Int64Value(900),
+		},
+		"other schedule": {
+			config: model("daily", types.Int64Null()),
+			plan:   model("daily", types.Int64Value(900)),
+			want:   types.Int64Unknown(),
+		},
+		"configured interval": {
+			config: model("indefinitely", types.Int64Value(60)),
+			plan:   model("indefinitely", types.Int64Value(60)),
+			want:   types.Int64Value(60),
+		},
+	}
+
+	// The interval kept from state belongs to the schedule in state
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: raw(model("indefinitely", types.Int64Value(900)))}
+
+	for name, testCase := range testCases {
+		req := resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw(testCase.config)},
+			Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw(testCase.plan)},
+			State:  state,
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+		planSchedulingInterval(ctx, req, resp)
+
+		var interval types.Int64
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("scheduling").AtName("interval"), &interval)...)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: unexpected diagnostics: %v", name, resp.Diagnostics)
+		} else if !interval.Equal(testCase.want) {
+			t.Errorf("%s: expected interval %s, got %s", name, testCase.want, interval)
+		}
+	}
+}
+
+func TestMakeAPIFake(t *testing.T) {
+	ctx := context.Background()
+
+	r := &TeamResource{}
+	configureResp := &resource.ConfigureResponse{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: &fakeTeamAPI{
+		teams: map[string]*makeapi.TeamResponse{"7": {ID: "7", Name: "Automation", OrganizationID: "3"}},
+	}}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &TeamResourceModel{Id: types.StringValue("7"), Name: types.StringValue("Old name"), Timeouts: nullTimeouts(schemaResp.Schema)}); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data TeamResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if data.Name.ValueString() != "Automation" || data.OrganizationId.ValueString() != "3" {
+		t.Errorf("Expected the team read from the fake, got %v", data)
+	}
+
+	// Resources of an unconfigured provider get a client whose plan-time
+	// methods handle the missing configuration
+	r = &TeamResource{}
+	r.Configure(ctx, resource.ConfigureRequest{}, &resource.ConfigureResponse{})
+	var diags diag.Diagnostics
+	r.client.AddQuotaWarning(&diags)
+	if diags.HasError() {
+		t.Errorf("Unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestTeamObjects(t *testing.T) {
+	server := newMockMakeAPI()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := &apiClient{&makeapi.Client{ApiToken: testMockAPIToken, BaseUrl: server.URL, HTTPClient: server.Client()}}
+
+	if _, err := client.CreateScenario(ctx, makeapi.ScenarioRequest{Name: "Orders", TeamID: "7"}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := client.CreateConnection(ctx, makeapi.ConnectionRequest{Name: "Mailbox", AppName: "gmail", TeamID: "8"}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := client.CreateDataStore(ctx, makeapi.DataStoreRequest{Name: "Customers", TeamID: "7"}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	testCases := map[string]string{
+		"7": `scenario "Orders" (ID 1), data store "Customers" (ID 3)`,
+		"8": `connection "Mailbox" (ID 2)`,
+		"9": "",
+	}
+
+	for teamID, expected := range testCases {
+		objects, err := teamObjects(ctx, client, teamID)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if list := objectList(objects, teamObjectsListed); list != expected {
+			t.Errorf("Expected the objects of team %s to be %s, got %s", teamID, expected, list)
+		}
+	}
+
+	if list := objectList([]string{"a", "b", "c"}, 2); list != "a, b and 1 more" {
+		t.Errorf("Expected the list to be truncated, got %s", list)
+	}
+}
+
+func TestMockMakeAPI(t *testing.T) {
+	server := newMockMakeAPI()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := &makeapi.Client{ApiToken: testMockAPIToken, BaseUrl: server.URL, HTTPClient: server.Client(), Cache: makeapi.NewResponseCache()}
+
+	team, err := client.CreateTeam(ctx, makeapi.TeamRequest{Name: "Automation", OrganizationID: "3"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	team, err = client.UpdateTeam(ctx, team.ID.String(), makeapi.TeamRequest{Name: "Automation", OrganizationID: "3"}, makeapi.TeamRequest{Name: "Operations", OrganizationID: "3"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if team.Name != "Operations" || team.OrganizationID != "3" {
+		t.Errorf("Expected the updated team, got %+v", team)
+	}
+
+	if _, err := client.CreateTeamVariable(ctx, team.ID.String(), makeapi.CustomVariableRequest{Name: "token", Value: "secret", Type: "string", IsSecret: true}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	variable, err := client.GetTeamVariable(ctx, team.ID.String(), "token")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if variable.TeamID != team.ID || variable.Value != nil {
+		t.Errorf("Expected the variable of team %s without its secret value, got %+v", team.ID, variable)
+	}
+
+	teams, err := client.ListTeams(ctx, "3")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(teams) != 1 || teams[0].ID != team.ID {
+		t.Errorf("Expected the team to be listed, got %+v", teams)
+	}
+
+	if err := client.DeleteTeam(ctx, team.ID.String()); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := client.GetTeam(ctx, team.ID.String()); !makeapi.IsNotFound(err) {
+		t.Errorf("Expected a not found error after deleting the team, got %v", err)
+	}
+
+	// Endpoints the mock does not serve are not found
+	if _, err := client.GetScimUser(ctx, "1"); !makeapi.IsNotFound(err) {
+		t.Errorf("Expected a not found error for SCIM users, got %v", err)
+	}
+}
+
+func TestConnectionVerificationWarning(t *testing.T) {
+	server := newMockMakeAPI()
+	defer server.Close()
+
+	t.Setenv("MAKE_API_TOKEN", testMockAPIToken)
+	t.Setenv("MAKE_BASE_URL", server.URL+"/")
+	t.Setenv("MAKE_API_PATH_PREFIX", "")
+
+	ctx := context.Background()
+	client := &makeapi.Client{ApiToken: testMockAPIToken, BaseUrl: server.URL, HTTPClient: server.Client()}
+	api := server.Config.Handler.(*mockMakeAPI)
+
+	// The connection is read through the provider server, which manages the
+	// private state Read records the object version in
+	providerServer, err := testAccProtoV6ProviderFactories["make"]()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	configType := schemaResp.Provider.ValueType().(tftypes.Object)
+	configAttributes := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+	for name, attributeType := range configType.AttributeTypes {
+		configAttributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	config, err := tfprotov6.NewDynamicValue(configType, tftypes.NewValue(configType, configAttributes))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	configureResp, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &config})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for _, d := range configureResp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("Unexpected error configuring the provider: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	var resourceSchema resource.SchemaResponse
+	(&ConnectionResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+	tests := []struct {
+		name          string
+		stateVerified bool
+		apiVerified   bool
+		expected      []string
+	}{
+		{name: "revoked", stateVerified: true, apiVerified: false, expected: []string{"Connection No Longer Verified"}},
+		{name: "still verified", stateVerified: true, apiVerified: true},
+		{name: "never verified", stateVerified: false, apiVerified: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connection, err := client.CreateConnection(ctx, makeapi.ConnectionRequest{Name: "Mailbox", AppName: "gmail"})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			// Make reports whether the credentials of the connection still work
+			api.mu.Lock()
+			api.objects["v2/connections/"+connection.ID.String()].Fields["verified"] = tt.apiVerified
+			api.mu.Unlock()
+
+			state := tfsdk.State{Schema: resourceSchema.Schema, Raw: tftypes.NewValue(resourceSchema.Schema.Type().TerraformType(ctx), nil)}
+			diags := state.Set(ctx, ConnectionResourceModel{
+				Id:          types.StringValue(connection.ID.String()),
+				Name:        types.StringValue("Mailbox"),
+				AppName:     types.StringValue("gmail"),
+				TeamId:      types.StringNull(),
+				Settings:    types.MapNull(types.StringType),
+				Parameters:  types.DynamicNull(),
+				Verified:    types.BoolValue(tt.stateVerified),
+				AuthProfile: types.StringNull(),
+				Timeouts:    nullTimeouts(resourceSchema.Schema),
+			})
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			currentState, err := tfprotov6.NewDynamicValue(state.Raw.Type(), state.Raw)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			readResp, err := providerServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{TypeName: "make_connection", CurrentState: &currentState})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			var warnings []string
+			for _, d := range readResp.Diagnostics {
+				switch d.Severity {
+				case tfprotov6.DiagnosticSeverityError:
+					t.Errorf("Unexpected error: %s: %s", d.Summary, d.Detail)
+				case tfprotov6.DiagnosticSeverityWarning:
+					warnings = append(warnings, d.Summary)
+				}
+			}
+			if strings.Join(warnings, "\n") != strings.Join(tt.expected, "\n") {
+				t.Errorf("Expected warnings %q, got %q", tt.expected, warnings)
+			}
+		})
+	}
+}
+
+func TestCassetteTransport(t *testing.T) {
+	t.Setenv("MAKE_API_TOKEN", testMockAPIToken)
+	server := newMockMakeAPI()
+
+	ctx := context.Background()
+	recorder := newCassetteRecorder()
+	client := &makeapi.Client{
+		ApiToken:   testMockAPIToken,
+		BaseUrl:    server.URL,
+		HTTPClient: &http.Client{Transport: recorder.wrap(http.DefaultTransport)},
+		Cache:      makeapi.NewResponseCache(),
+	}
+
+	team, err := client.CreateTeam(ctx, makeapi.TeamRequest{Name: "Automation", OrganizationID: "3"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for range 2 {
+		if _, err := client.GetTeam(ctx, team.ID.String()); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	server.Close()
+
+	// Conditional requests are recorded unconditionally, with full bodies
+	if got := len(recorder.cassette.Interactions); got != 3 {
+		t.Fatalf("Expected 3 recorded interactions, got %d", got)
+	}
+	if last := recorder.cassette.Interactions[2]; last.Status != http.StatusOK || last.ResponseBody == "" {
+		t.Errorf("Expected the repeated GET to be recorded with its body, got %+v", last)
+	}
+
+	replay := &cassetteTransport{cassette: recorder.cassette}
+	client.HTTPClient = &http.Client{Transport: replay.wrap(nil)}
+	client.Cache = makeapi.NewResponseCache()
+
+	replayed, err := client.CreateTeam(ctx, makeapi.TeamRequest{Name: "Automation", OrganizationID: "3"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if replayed.ID != team.ID {
+		t.Errorf("Expected the recorded team %s, got %+v", team.ID, replayed)
+	}
+	for range 2 {
+		if _, err := client.GetTeam(ctx, team.ID.String()); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	// Requests that were not recorded, or were replayed already, fail
+	if _, err := client.CreateTeam(ctx, makeapi.TeamRequest{Name: "Automation", OrganizationID: "3"}); err == nil {
+		t.Error("Expected an error for a request without a recorded interaction")
+	}
+
+	// Recorded bodies are redacted like the debug_http logs of the client
+	redactions := map[string]struct {
+		urlPath  string
+		body     string
+		expected string
+	}{
+		"token": {
+			urlPath:  "/v2/teams",
+			body:     `{"name":"CI","token":"abc","nested":[{"client_secret":"def"}],"note":"` + testMockAPIToken + `"}`,
+			expected: `{"name":"CI","nested":[{"client_secret":"REDACTED"}],"note":"REDACTED","token":"REDACTED"}`,
+		},
+		"connection settings": {
+			urlPath:  "/v2/connections",
+			body:     `{"settings":{"apiKey":"k","privateKey":"p","region":"eu"}}`,
+			expected: `{"settings":{"apiKey":"REDACTED","privateKey":"REDACTED","region":"eu"}}`,
+		},
+		"keychain parameters": {
+			urlPath:  "/v2/keys/1",
+			body:     `{"parameters":{"username":"ci","pass":"p"}}`,
+			expected: `{"parameters":{"pass":"REDACTED","username":"REDACTED"}}`,
+		},
+		"audit log export headers": {
+			urlPath:  "/v2/organizations/3/audit-log-export",
+			body:     `{"headers":{"X-Api-Auth":"h"}}`,
+			expected: `{"headers":{"X-Api-Auth":"REDACTED"}}`,
+		},
+		"secret team variable": {
+			urlPath:  "/v2/teams/1/variables",
+			body:     `{"name":"signing","value":"s","is_secret":true}`,
+			expected: `{"is_secret":true,"name":"signing","value":"REDACTED"}`,
+		},
+	}
+	for name, redaction := range redactions {
+		if redacted := redact(redaction.urlPath, []byte(redaction.body)); redacted != redaction.expected {
+			t.Errorf("%s: expected %s, got %s", name, redaction.expected, redacted)
+		}
+	}
+}
+
+func TestStringListValueLike(t *testing.T) {
+	ctx := context.Background()
+	prior := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("203.0.113.10"),
+		types.StringValue("10.0.0.1"),
+	})
+
+	// Same elements in a different order keep the prior ordering
+	result := stringListValueLike(ctx, prior, []string{"10.0.0.1", "203.0.113.10"})
+	if !result.Equal(prior) {
+		t.Errorf("Expected prior list to be kept, got %v", result)
+	}
+
+	// Changed elements are stored sorted
+	result = stringListValueLike(ctx, prior, []string{"203.0.113.10", "192.168.1.0/24"})
+	expected := sortedStringListValue([]string{"192.168.1.0/24", "203.0.113.10"})
+	if !result.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	// A null prior value behaves like sortedStringListValue
+	result = stringListValueLike(ctx, types.ListNull(types.StringType), nil)
+	if !result.IsNull() {
+		t.Errorf("Expected a null list, got %v", result)
+	}
+}
+
+func TestIPOrCIDRValidator(t *testing.T) {
+	testCases := map[string]bool{
+		"203.0.113.10":   true,
+		"203.0.113.0/24": true,
+		"2001:db8::1":    true,
+		"2001:db8::/32":  true,
+		"203.0.113.256":  false,
+		"example.com":    false,
+		"10.0.0.0/33":    false,
+	}
+
+	for value, valid := range testCases {
+		req := validator.StringRequest{
+			Path:        path.Root("ip_restrictions").AtListIndex(0),
+			ConfigValue: types.StringValue(value),
+		}
+		resp := &validator.StringResponse{}
+
+		ipOrCIDR().ValidateString(context.Background(), req, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("Expected %q to be valid: %t, got diagnostics: %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestJSONObjectValidator(t *testing.T) {
+	testCases := map[string]bool{
+		`{"name":"Alice"}`: true,
+		`{}`:               true,
+		`["Alice"]`:        false,
+		`"Alice"`:          false,
+		`null`:             false,
+		`{"name":`:         false,
+	}
+
+	for value, valid := range testCases {
+		req := validator.StringRequest{
+			Path:        path.Root("records").AtMapKey("alice"),
+			ConfigValue: types.StringValue(value),
+		}
+		resp := &validator.StringResponse{}
+
+		jsonObject().ValidateString(context.Background(), req, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("Expected %q to be valid: %t, got diagnostics: %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestRFC3339Validator(t *testing.T) {
+	testCases := map[string]bool{
+		"2025-01-31T00:00:00Z":      true,
+		"2025-01-31T08:30:00+02:00": true,
+		"2025-01-31":                false,
+		"yesterday":                 false,
+	}
+
+	for value, valid := range testCases {
+		req := validator.StringRequest{
+			Path:        path.Root("from"),
+			ConfigValue: types.StringValue(value),
+		}
+		resp := &validator.StringResponse{}
+
+		rfc3339().ValidateString(context.Background(), req, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("Expected %q to be valid: %t, got diagnostics: %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestAttributeValidators(t *testing.T) {
+	testCases := map[string]struct {
+		validators []validator.String
+		value      string
+		valid      bool
+	}{
+		"name":                 {validators: nameValidators(), value: "Invoicing (EU)", valid: true},
+		"empty name":           {validators: nameValidators(), value: ""},
+		"blank name":           {validators: nameValidators(), value: "  "},
+		"long name":            {validators: nameValidators(), value: strings.Repeat("a", maxNameLength+1)},
+		"app name":             {validators: appNameValidators(), value: "google-sheets", valid: true},
+		"app label":            {validators: appNameValidators(), value: "Google Sheets"},
+		"email":                {validators: emailValidators(), value: "jane@example.com", valid: true},
+		"name instead of mail": {validators: emailValidators(), value: "Jane Doe"},
+		"reference ID":         {validators: []validator.String{referenceID()}, value: "123", valid: true},
+		"reference URL":        {validators: []validator.String{referenceID()}, value: "https://eu1.make.com/123"},
+	}
+
+	for name, testCase := range testCases {
+		req := validator.StringRequest{
+			Path:        path.Root("name"),
+			ConfigValue: types.StringValue(testCase.value),
+		}
+		resp := &validator.StringResponse{}
+
+		for _, v := range testCase.validators {
+			v.ValidateString(context.Background(), req, resp)
+		}
+
+		if resp.Diagnostics.HasError() == testCase.valid {
+			t.Errorf("%s: expected %q to be valid: %t, got diagnostics: %v", name, testCase.value, testCase.valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestCheckCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token valid-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]string{"message": "Invalid token"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(makeapi.UserResponse{ID: "1", Email: "jane@example.com"})
+	}))
+	defer server.Close()
+
+	testCases := map[string]bool{
+		"valid-token":   true,
+		"revoked-token": false,
+	}
+
+	for token, valid := range testCases {
+		var diags diag.Diagnostics
+
+		client := &makeapi.Client{ApiToken: token, BaseUrl: server.URL, HTTPClient: server.Client()}
+		checkCredentials(context.Background(), client, "agency", &diags)
+
+		if diags.HasError() == valid {
+			t.Errorf("Expected valid=%t for token %s, got diagnostics: %v", valid, token, diags)
+		}
+
+		if !valid && !strings.Contains(diags.Errors()[0].Detail(), `auth profile "agency"`) {
+			t.Errorf("Expected the error to name the auth profile, got: %s", diags.Errors()[0].Detail())
+		}
+	}
+}
+
+func TestReadTokenFile(t *testing.T) {
+	dir := t.TempDir()
+
+	name := filepath.Join(dir, "token")
+	if err := os.WriteFile(name, []byte("secret-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := readTokenFile(name)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if token != "secret-token" {
+		t.Errorf("Expected token 'secret-token', got %q", token)
+	}
+
+	empty := filepath.Join(dir, "empty")
+	if err := os.WriteFile(empty, []byte(" \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := readTokenFile(empty); err == nil {
+		t.Error("Expected an error for an empty token file")
+	}
+
+	if _, err := readTokenFile(filepath.Join(dir, "missing")); err == nil {
+		t.Error("Expected an error for a missing token file")
+	}
+}
+
+func TestRunTokenCommand(t *testing.T) {
+	if _, err := exec.LookPath("echo"); err != nil {
+		t.Skip("echo is not available")
+	}
+
+	token, err := runTokenCommand(context.Background(), []string{"echo", "secret-token"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if token != "secret-token" {
+		t.Errorf("Expected token 'secret-token', got %q", token)
+	}
+
+	if _, err := runTokenCommand(context.Background(), []string{"echo"}); err == nil {
+		t.Error("Expected an error for a command printing no token")
+	}
+
+	if _, err := runTokenCommand(context.Background(), nil); err == nil {
+		t.Error("Expected an error for an empty command")
+	}
+}
+
+func TestDataStoreRecords(t *testing.T) {
+	records := map[string]string{
+		"b": `{"name":"Bob"}`,
+		"a": `{"name":"Alice"}`,
+		"c": `{"name":"Carol"}`,
+	}
+	prior := map[string]string{
+		"a": `{ "name": "Alice" }`,
+		"c": `{"name":"Caroline"}`,
+	}
+
+	result := dataStoreRecords(records, prior)
+
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 changed records, got %d: %v", len(result), result)
+	}
+	if result[0].Key != "b" || result[1].Key != "c" {
+		t.Errorf("Expected changed records b and c in order, got %s and %s", result[0].Key, result[1].Key)
+	}
+	if string(result[1].Data) != `{"name":"Carol"}` {
+		t.Errorf("Expected record c to carry the planned data, got %s", result[1].Data)
+	}
+
+	if all := dataStoreRecords(records, nil); len(all) != 3 {
+		t.Errorf("Expected every record without prior records, got %d", len(all))
+	}
+}
+
+func TestValidateConnectionParameters(t *testing.T) {
+	params := []makeapi.AppParameter{
+		{Name: "url", Label: "URL", Type: "url", Required: true},
+		{Name: "timeout", Type: "uinteger"},
+		{Name: "verify", Type: "boolean"},
+		{Name: "method", Type: "select", Options: []makeapi.AppParameterOption{{Value: "GET"}, {Value: "POST"}}},
+		{Name: "scopes", Type: "array"},
+	}
+
+	testCases := map[string]struct {
+		parameters map[string]attr.Value
+		want       []string
+	}{
+		"valid": {
+			parameters: map[string]attr.Value{
+				"url":     types.StringValue("https://example.com"),
+				"timeout": types.NumberValue(big.NewFloat(30)),
+				"verify":  types.BoolValue(true),
+				"method":  types.StringValue("POST"),
+				"scopes":  types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("read")}),
+			},
+		},
+		"unknown values": {
+			parameters: map[string]attr.Value{"url": types.StringUnknown(), "timeout": types.NumberUnknown()},
+		},
+		"unknown parameter": {
+			parameters: map[string]attr.Value{"url": types.StringValue("https://example.com"), "uri": types.StringValue("x")},
+			want:       []string{"Unknown Connection Parameter"},
+		},
+		"missing required parameter": {
+			parameters: map[string]attr.Value{"verify": types.BoolValue(false)},
+			want:       []string{"Missing Connection Parameter"},
+		},
+		"wrong types": {
+			parameters: map[string]attr.Value{
+				"url":     types.BoolValue(true),
+				"timeout": types.NumberValue(big.NewFloat(-1)),
+				"verify":  types.StringValue("yes"),
+				"method":  types.StringValue("PUT"),
+			},
+			want: []string{"Invalid Connection Parameter", "Invalid Connection Parameter", "Invalid Connection Parameter", "Invalid Connection Parameter"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		diags := validateConnectionParameters(path.Root("parameters"), "http", params, testCase.parameters)
+
+		var got []string
+		for _, d := range diags.Errors() {
+			got = append(got, d.Summary())
+		}
+		if strings.Join(got, ",") != strings.Join(testCase.want, ",") {
+			t.Errorf("%s: expected errors %v, got %v", name, testCase.want, diags)
+		}
+	}
+}
+
+func TestDynamicValueJSON(t *testing.T) {
+	remote := map[string]interface{}{
+		"host":   "db.example.com",
+		"port":   float64(5432),
+		"ssl":    true,
+		"hosts":  []interface{}{"a", float64(1)},
+		"extra":  map[string]interface{}{"schema": "public", "unset": nil},
+		"absent": nil,
+	}
+
+	value, err := dynamicValueFromJSON(remote)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	converted, err := dynamicValueToJSON(types.DynamicValue(value))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	got, _ := json.Marshal(converted)
+	want := `{"extra":{"schema":"public"},"host":"db.example.com","hosts":["a",1],"port":5432,"ssl":true}`
+	if string(got) != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+
+	if _, err := dynamicValueToJSON(types.DynamicUnknown()); err == nil {
+		t.Errorf("Expected an error for an unknown value")
+	}
+}
+
+func TestConnectionParametersValue(t *testing.T) {
+	prior := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"host": types.StringType, "port": types.NumberType, "password": types.StringType},
+		map[string]attr.Value{
+			"host":     types.StringValue("db.example.com"),
+			"port":     types.NumberValue(big.NewFloat(5432)),
+			"password": types.StringValue("s3cr3t"),
+		},
+	))
+
+	testCases := map[string]struct {
+		prior  types.Dynamic
+		remote map[string]interface{}
+		want   string
+	}{
+		"not configured":       {prior: types.DynamicNull(), remote: map[string]interface{}{"host": "db"}, want: `null`},
+		"no remote parameters": {prior: prior, want: `{"host":"db.example.com","password":"s3cr3t","port":5432}`},
+		"unchanged without secrets": {
+			prior:  prior,
+			remote: map[string]interface{}{"host": "db.example.com", "port": float64(5432), "database": "app"},
+			want:   `{"host":"db.example.com","password":"s3cr3t","port":5432}`,
+		},
+		"changed in Make": {
+			prior:  prior,
+			remote: map[string]interface{}{"host": "replica.example.com", "port": float64(5432)},
+			want:   `{"host":"replica.example.com","password":"s3cr3t","port":5432}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		value, err := connectionParametersValue(testCase.prior, testCase.remote)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+
+		converted, err := dynamicValueToJSON(value)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+		if got, _ := json.Marshal(converted); string(got) != testCase.want {
+			t.Errorf("%s: expected %s, got %s", name, testCase.want, got)
+		}
+	}
+}
+
+func TestJSONNormalized(t *testing.T) {
+	testCases := map[string]struct {
+		prior, value string
+		equal        bool
+		valid        bool
+	}{
+		"identical":       {prior: `{"a":1}`, value: `{"a":1}`, equal: true, valid: true},
+		"key order":       {prior: `{"a":1,"b":2}`, value: `{ "b": 2, "a": 1 }`, equal: true, valid: true},
+		"whitespace":      {prior: `[1, 2]`, value: "[\n  1,\n  2\n]", equal: true, valid: true},
+		"changed value":   {prior: `{"a":1}`, value: `{"a":2}`, valid: true},
+		"array order":     {prior: `[1,2]`, value: `[2,1]`, valid: true},
+		"invalid payload": {prior: `{"a":1}`, value: `{"a":`},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// Invalid JSON is reported by validation rather than compared
+			if tc.valid {
+				equal, diags := jsontypes.NewNormalizedValue(tc.prior).StringSemanticEquals(context.Background(), jsontypes.NewNormalizedValue(tc.value))
+				if diags.HasError() {
+					t.Fatalf("Unexpected error: %v", diags)
+				}
+				if equal != tc.equal {
+					t.Errorf("Expected semantic equality %t, got %t", tc.equal, equal)
+				}
+			}
+
+			resp := &xattr.ValidateAttributeResponse{}
+			jsontypes.NewNormalizedValue(tc.value).ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("blueprint")}, resp)
+			if resp.Diagnostics.HasError() == tc.valid {
+				t.Errorf("Expected valid %t, got diagnostics: %v", tc.valid, resp.Diagnostics)
+			}
+		})
+	}
+
+	// Values read back from the API are converted to the custom type
+	value, err := jsontypes.NormalizedType{}.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, `{"a":1}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !value.Equal(jsontypes.NewNormalizedValue(`{"a":1}`)) {
+		t.Errorf("Expected a jsontypes.Normalized value, got %#v", value)
+	}
+}
+
+func TestRequiresReplaceOwner(t *testing.T) {
+	testCases := map[string]struct {
+		state, plan types.String
+		want        bool
+	}{
+		"unchanged team":          {state: types.StringValue("1"), plan: types.StringValue("1")},
+		"changed team":            {state: types.StringValue("1"), plan: types.StringValue("2"), want: true},
+		"team of a new reference": {state: types.StringValue("1"), plan: types.StringUnknown(), want: true},
+		"team missing from state": {state: types.StringNull(), plan: types.StringValue("2")},
+	}
+
+	// The resource exists in both the state and the plan
+	object := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+	for name, testCase := range testCases {
+		req := planmodifier.StringRequest{
+			Path:       path.Root("team_id"),
+			State:      tfsdk.State{Raw: object},
+			Plan:       tfsdk.Plan{Raw: object},
+			StateValue: testCase.state,
+			PlanValue:  testCase.plan,
+		}
+		resp := &planmodifier.StringResponse{PlanValue: testCase.plan}
+
+		requiresReplaceOwner("Make cannot move it.").PlanModifyString(context.Background(), req, resp)
+
+		if resp.RequiresReplace != testCase.want {
+			t.Errorf("%s: expected replacement=%t, got %t", name, testCase.want, resp.RequiresReplace)
+		}
+	}
+}
+
+func TestPlanDefaultIDs(t *testing.T) {
+	ctx := context.Background()
+
+	var schema resource.SchemaResponse
+	(&ConnectionResource{}).Schema(ctx, resource.SchemaRequest{}, &schema)
+	objectType := schema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// newValue returns a connection with every attribute null but team_id
+	newValue := func(teamID tftypes.Value) tftypes.Value {
+		attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+		attributes["team_id"] = teamID
+
+		return tftypes.NewValue(objectType, attributes)
+	}
+
+	testCases := map[string]struct {
+		client    *apiClient
+		required  bool
+		want      types.String
+		wantError bool
+	}{
+		"default team":             {client: &apiClient{Client: &makeapi.Client{DefaultTeamID: "7"}}, want: types.StringValue("7")},
+		"no default team":          {client: &apiClient{Client: &makeapi.Client{}}, want: types.StringUnknown()},
+		"unconfigured provider":    {client: nil, want: types.StringUnknown()},
+		"required without default": {client: &apiClient{Client: &makeapi.Client{}}, required: true, wantError: true},
+	}
+
+	for name, testCase := range testCases {
+		req := resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: schema.Schema, Raw: newValue(tftypes.NewValue(tftypes.String, nil))},
+			Plan:   tfsdk.Plan{Schema: schema.Schema, Raw: newValue(tftypes.NewValue(tftypes.String, tftypes.UnknownValue))},
+			State:  tfsdk.State{Schema: schema.Schema, Raw: tftypes.NewValue(objectType, nil)},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+		testCase.client.PlanDefaultIDs(ctx, req, resp, defaultID{Path: path.Root("team_id"), Required: testCase.required})
+
+		if resp.Diagnostics.HasError() != testCase.wantError {
+			t.Errorf("%s: expected error=%t, got diagnostics: %v", name, testCase.wantError, resp.Diagnostics)
+			continue
+		}
+		if testCase.wantError {
+			continue
+		}
+
+		// Without a default the team stays unknown for the API to pick, as
+		// a null plan would not match the team stored after apply
+		var teamID types.String
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("team_id"), &teamID)...)
+		if !teamID.Equal(testCase.want) {
+			t.Errorf("%s: expected team_id %s, got %s", name, testCase.want, teamID)
+		}
+	}
+}
+
+func TestLoadCustomAppIcon(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	iconPath := filepath.Join(t.TempDir(), "icon.png")
+	if err := os.WriteFile(iconPath, png, 0o600); err != nil {
+		t.Fatalf("Unable to write icon file: %s", err)
+	}
+
+	for _, icon := range []string{iconPath, base64.StdEncoding.EncodeToString(png)} {
+		content, err := loadCustomAppIcon(icon)
+		if err != nil {
+			t.Errorf("Expected icon %q to load, got error: %s", icon, err)
+			continue
+		}
+		if customAppIconHash(content) != customAppIconHash(png) {
+			t.Errorf("Expected icon %q to load the PNG content", icon)
+		}
+	}
+
+	for _, icon := range []string{"missing.png", base64.StdEncoding.EncodeToString([]byte("GIF89a"))} {
+		if _, err := loadCustomAppIcon(icon); err == nil {
+			t.Errorf("Expected icon %q to be rejected", icon)
+		}
+	}
+}
+
+func TestStateUpgraders(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "make"}, &metadata)
+
+		var schema resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schema)
+
+		upgrader, ok := r.(resource.ResourceWithUpgradeState)
+		if !ok {
+			t.Errorf("%s: does not implement UpgradeState", metadata.TypeName)
+			continue
+		}
+
+		upgraders := upgrader.UpgradeState(ctx)
+		for version := range schema.Schema.Version {
+			if _, ok := upgraders[version]; !ok {
+				t.Errorf("%s: no state upgrader from schema version %d", metadata.TypeName, version)
+			}
+		}
+	}
+}
+
+func TestUpgradeStateJSON(t *testing.T) {
+	upgrader := upgradeStateJSON(func(state map[string]interface{}) error {
+		if _, ok := state["blueprint_json"]; !ok {
+			return errors.New("missing blueprint_json")
+		}
+		state["blueprint"] = state["blueprint_json"]
+		delete(state, "blueprint_json")
+		return nil
+	})
+
+	testCases := map[string]struct {
+		state     string
+		want      string
+		wantError bool
+	}{
+		"renamed attribute": {
+			state: `{"id":"1","blueprint_json":"{}"}`,
+			want:  `{"blueprint":"{}","id":"1"}`,
+		},
+		"upgrade error": {state: `{"id":"1"}`, wantError: true},
+		"invalid JSON":  {state: `{`, wantError: true},
+	}
+
+	for name, testCase := range testCases {
+		req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(testCase.state)}}
+		var resp resource.UpgradeStateResponse
+		upgrader.StateUpgrader(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() != testCase.wantError {
+			t.Errorf("%s: expected error=%t, got diagnostics: %v", name, testCase.wantError, resp.Diagnostics)
+			continue
+		}
+		if !testCase.wantError && string(resp.DynamicValue.JSON) != testCase.want {
+			t.Errorf("%s: expected state %s, got %s", name, testCase.want, resp.DynamicValue.JSON)
+		}
+	}
+}
+
+func TestUpgradeWebhookSettings(t *testing.T) {
+	upgrader := (&WebhookResource{}).UpgradeState(context.Background())[0]
+
+	testCases := map[string]struct {
+		state     string
+		want      string
+		wantError bool
+	}{
+		"no settings": {
+			state: `{"id":"1","settings":null}`,
+			want:  `{"id":"1","settings":null}`,
+		},
+		"string flags": {
+			state: `{"id":"1","settings":{"headers":"true","method":"false","secret":"s3cr3t"}}`,
+			want:  `{"id":"1","settings":{"headers":true,"method":false,"stringify":false}}`,
+		},
+		"invalid flag": {state: `{"id":"1","settings":{"stringify":"yes please"}}`, wantError: true},
+	}
+
+	for name, testCase := range testCases {
+		req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(testCase.state)}}
+		var resp resource.UpgradeStateResponse
+		upgrader.StateUpgrader(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() != testCase.wantError {
+			t.Errorf("%s: expected error=%t, got diagnostics: %v", name, testCase.wantError, resp.Diagnostics)
+			continue
+		}
+		if !testCase.wantError && string(resp.DynamicValue.JSON) != testCase.want {
+			t.Errorf("%s: expected state %s, got %s", name, testCase.want, resp.DynamicValue.JSON)
+		}
+	}
+}
+
+func TestWebhookSettingsModelLike(t *testing.T) {
+	configured := &WebhookSettingsModel{Method: types.BoolValue(false), Headers: types.BoolValue(false), Stringify: types.BoolValue(false)}
+
+	testCases := map[string]struct {
+		prior    *WebhookSettingsModel
+		settings *makeapi.WebhookSettings
+		want     *WebhookSettingsModel
+	}{
+		"no settings":             {prior: configured},
+		"defaults not configured": {settings: &makeapi.WebhookSettings{}},
+		"defaults configured":     {prior: configured, settings: &makeapi.WebhookSettings{}, want: configured},
+		"changed in Make": {
+			settings: &makeapi.WebhookSettings{Headers: true},
+			want:     &WebhookSettingsModel{Method: types.BoolValue(false), Headers: types.BoolValue(true), Stringify: types.BoolValue(false)},
+		},
+	}
+
+	for name, testCase := range testCases {
+		if got := webhookSettingsModelLike(testCase.prior, testCase.settings); !webhookSettingsEqual(got, testCase.want) {
+			t.Errorf("%s: expected %+v, got %+v", name, testCase.want, got)
+		}
+	}
+}
+
+func TestResourceIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "make"}, &metadata)
+
+		identityResource, ok := r.(resource.ResourceWithIdentity)
+		if _, importable := r.(resource.ResourceWithImportState); ok != importable {
+			t.Errorf("%s: expected an identity exactly if the resource can be imported", metadata.TypeName)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		var schema resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schema)
+
+		var identity resource.IdentitySchemaResponse
+		identityResource.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identity)
+
+		// Identity attributes are copied from the state attributes of the same name
+		for name, attribute := range identity.IdentitySchema.Attributes {
+			stateAttribute, ok := schema.Schema.Attributes[name]
+			if !ok || !stateAttribute.GetType().Equal(attribute.GetType()) {
+				t.Errorf("%s: identity attribute %s has no state attribute of its type", metadata.TypeName, name)
+			}
+		}
+	}
+}
+
+func TestSetIdentity(t *testing.T) {
+	ctx := context.Background()
+	r := &TeamMemberResource{}
+
+	var schema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schema)
+
+	var identitySchema resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	newIdentity := func() *tfsdk.ResourceIdentity {
+		identityType := identitySchema.IdentitySchema.Type().TerraformType(ctx)
+		return &tfsdk.ResourceIdentity{Schema: identitySchema.IdentitySchema, Raw: tftypes.NewValue(identityType, nil)}
+	}
+
+	state := tfsdk.State{Schema: schema.Schema, Raw: tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, TeamMemberResourceModel{
+		Id:          types.StringValue("1/2"),
+		TeamId:      types.StringValue("1"),
+		UserId:      types.StringValue("2"),
+		Role:        types.StringValue("team_member"),
+		AuthProfile: types.StringNull(),
+		Timeouts:    nullTimeouts(schema.Schema),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unable to set state: %v", diags)
+	}
+
+	identity := newIdentity()
+	setIdentity(ctx, &state, identity, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	var userID types.String
+	identity.GetAttribute(ctx, path.Root("user_id"), &userID)
+	if userID.ValueString() != "2" {
+		t.Errorf("Expected identity user_id 2, got %s", userID)
+	}
+
+	// Importing by identity builds the import ID of the resource
+	req := resource.ImportStateRequest{Identity: identity}
+	if id := identityImportID(req, &diags, "team_id", "user_id"); id != "1/2" || diags.HasError() {
+		t.Errorf("Expected import ID 1/2, got %q: %v", id, diags)
+	}
+
+	req = resource.ImportStateRequest{ID: "3/4", Identity: newIdentity()}
+	if id := identityImportID(req, &diags, "team_id", "user_id"); id != "3/4" {
+		t.Errorf("Expected the import ID to take precedence, got %q", id)
+	}
+
+	// Terraform before 1.12 sends no identity
+	setIdentity(ctx, &state, nil, &diags)
+	if diags.HasError() {
+		t.Errorf("Unexpected error without identity: %v", diags)
+	}
+}
+
+func TestSetIdentityScope(t *testing.T) {
+	ctx := context.Background()
+	r := &WebhookResource{}
+
+	var schema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schema)
+
+	var identitySchema resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	testCases := map[string]struct {
+		teamID   types.String
+		importID string
+	}{
+		"team":    {teamID: types.StringValue("678"), importID: "678/12345"},
+		"no team": {teamID: types.StringNull(), importID: "12345"},
+	}
+
+	for name, testCase := range testCases {
+		var diags diag.Diagnostics
+		state := tfsdk.State{Schema: schema.Schema, Raw: tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil)}
+		diags.Append(state.SetAttribute(ctx, path.Root("id"), types.StringValue("12345"))...)
+		diags.Append(state.SetAttribute(ctx, path.Root("team_id"), testCase.teamID)...)
+
+		identity := &tfsdk.ResourceIdentity{
+			Schema: identitySchema.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchema.IdentitySchema.Type().TerraformType(ctx), nil),
+		}
+		setIdentity(ctx, &state, identity, &diags)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, diags)
+		}
+
+		// The owning team is part of the identity when it is known
+		var teamID types.String
+		identity.GetAttribute(ctx, path.Root("team_id"), &teamID)
+		if !teamID.Equal(testCase.teamID) {
+			t.Errorf("%s: expected identity team_id %s, got %s", name, testCase.teamID, teamID)
+		}
+
+		req := resource.ImportStateRequest{Identity: identity}
+		if id := identityImportID(req, &diags, "team_id", "id"); id != testCase.importID || diags.HasError() {
+			t.Errorf("%s: expected import ID %q, got %q: %v", name, testCase.importID, id, diags)
+		}
+	}
+}
+
+func TestImportScopedID(t *testing.T) {
+	ctx := context.Background()
+	r := &ScenarioResource{client: unconfiguredClient}
+
+	var schema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schema)
+
+	testCases := map[string]struct {
+		id        string
+		wantID    string
+		wantTeam  types.String
+		wantError bool
+	}{
+		"plain ID":       {id: "123", wantID: "123", wantTeam: types.StringNull()},
+		"team scoped ID": {id: "7/123", wantID: "123", wantTeam: types.StringValue("7")},
+		"missing team":   {id: "/123", wantError: true},
+		"missing ID":     {id: "7/", wantError: true},
+		"too many parts": {id: "7/123/4", wantError: true},
+	}
+
+	for name, testCase := range testCases {
+		resp := resource.ImportStateResponse{
+			State: tfsdk.State{Schema: schema.Schema, Raw: tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil)},
+		}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: testCase.id}, &resp)
+
+		if resp.Diagnostics.HasError() != testCase.wantError {
+			t.Errorf("%s: expected error=%t, got diagnostics: %v", name, testCase.wantError, resp.Diagnostics)
+			continue
+		}
+		if testCase.wantError {
+			continue
+		}
+
+		var id, teamID types.String
+		resp.State.GetAttribute(ctx, path.Root("id"), &id)
+		resp.State.GetAttribute(ctx, path.Root("team_id"), &teamID)
+		if id.ValueString() != testCase.wantID || !teamID.Equal(testCase.wantTeam) {
+			t.Errorf("%s: expected ID %s and team %s, got %s and %s", name, testCase.wantID, testCase.wantTeam, id, teamID)
+		}
+	}
+}
+
+func TestResolveImportName(t *testing.T) {
+	teams := map[string][]importCandidate{
+		"7": {{ID: "1", Name: "Invoicing"}, {ID: "2", Name: "Payroll"}, {ID: "3", Name: "Payroll"}, {ID: "4", Name: "CRM/Sync"}},
+		"8": {{ID: "5", Name: "Invoicing"}},
+	}
+	list := func(client MakeAPI, ctx context.Context, teamID string) ([]importCandidate, error) {
+		return teams[teamID], nil
+	}
+
+	client := &apiClient{Client: &makeapi.Client{DefaultTeamID: "7"}}
+
+	testCases := map[string]struct {
+		client    *apiClient
+		id        string
+		want      string
+		wantError string
+	}{
+		"plain ID":          {client: client, id: "123", want: "123"},
+		"team scoped ID":    {client: client, id: "8/123", want: "8/123"},
+		"default team":      {client: client, id: "name=Invoicing", want: "7/1"},
+		"team scoped name":  {client: client, id: "8/name=Invoicing", want: "8/5"},
+		"name with slash":   {client: client, id: "name=CRM/Sync", want: "7/4"},
+		"ambiguous name":    {client: client, id: "name=Payroll", wantError: "2 scenarios are named \"Payroll\" in team 7 (IDs 2, 3)"},
+		"unknown name":      {client: client, id: "name=Billing", wantError: "no scenario named \"Billing\" in team 7"},
+		"missing name":      {client: client, id: "name=", wantError: "missing scenario name"},
+		"no default team":   {client: &apiClient{Client: &makeapi.Client{}}, id: "name=Invoicing", wantError: "needs the team"},
+		"unconfigured name": {client: nil, id: "name=Invoicing", wantError: "not configured"},
+	}
+
+	for name, testCase := range testCases {
+		id, err := testCase.client.ResolveImportName(context.Background(), testCase.id, "scenario", list)
+		if testCase.wantError != "" {
+			if err == nil || !strings.Contains(err.Error(), testCase.wantError) {
+				t.Errorf("%s: expected error containing %q, got %v", name, testCase.wantError, err)
+			}
+			continue
+		}
+		if err != nil || id != testCase.want {
+			t.Errorf("%s: expected import ID %q, got %q: %v", name, testCase.want, id, err)
+		}
+	}
+}
+
+func TestImportBlockRendering(t *testing.T) {
+	testCases := map[string]struct {
+		object   adoptableObject
+		wantName string
+	}{
+		"words":         {object: adoptableObject{ResourceType: "make_scenario", ID: "1", Name: "Invoicing (EU)"}, wantName: "invoicing_eu"},
+		"duplicate":     {object: adoptableObject{ResourceType: "make_scenario", ID: "2", Name: "invoicing-eu"}, wantName: "invoicing_eu_2"},
+		"other type":    {object: adoptableObject{ResourceType: "make_webhook", ID: "3", Name: "Invoicing EU"}, wantName: "invoicing_eu"},
+		"leading digit": {object: adoptableObject{ResourceType: "make_connection", ID: "4", Name: "2FA Gmail"}, wantName: "connection_2fa_gmail"},
+		"no letters":    {object: adoptableObject{ResourceType: "make_connection", ID: "5", Name: "🚀"}, wantName: "connection"},
+	}
+
+	// Names are taken in order, so that the duplicate follows the first one
+	taken := map[string]bool{}
+	for _, name := range []string{"words", "duplicate", "other type", "leading digit", "no letters"} {
+		testCase := testCases[name]
+		if got := uniqueResourceName(testCase.object, taken); got != testCase.wantName {
+			t.Errorf("%s: expected resource name %s, got %s", name, testCase.wantName, got)
+		}
+	}
+
+	if got, want := hclString(`Order ${id} "%{x}"`), `"Order $${id} \"%%{x}\""`; got != want {
+		t.Errorf("expected HCL string %s, got %s", want, got)
+	}
+
+	object := adoptableObject{
+		ResourceType: "make_scenario",
+		ID:           "1",
+		Name:         "Invoicing",
+		Attributes:   [][2]string{{"active", "true"}, {"manage_blueprint", "false"}},
+	}
+	want := `resource "make_scenario" "invoicing" {
+  name             = "Invoicing"
+  team_id          = "7"
+  active           = true
+  manage_blueprint = false
+  auth_profile     = "eu"
+}
+`
+	if got := resourceSkeleton(object, "make_scenario.invoicing", "7", types.StringValue("eu")); got != want {
+		t.Errorf("expected skeleton:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+// nullTimeouts returns the timeouts block of a resource left unconfigured
+func nullTimeouts(s resourceschema.Schema) timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(s.Blocks["timeouts"].Type().(timeouts.Type).AttrTypes)}
+}
+
+func TestTimeouts(t *testing.T) {
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{"create": types.StringType, "delete": types.StringType}
+
+	testCases := map[string]struct {
+		timeouts     timeouts.Value
+		wantDeadline bool
+	}{
+		"no timeouts block": {timeouts: timeouts.Value{Object: types.ObjectNull(attrTypes)}},
+		"no timeout for the operation": {
+			timeouts: timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{"create": types.StringNull(), "delete": types.StringValue("1m")})},
+		},
+		"timeout for the operation": {
+			timeouts:     timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{"create": types.StringValue("10m"), "delete": types.StringNull()})},
+			wantDeadline: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		// Operations without a timeout keep each API call bounded by the
+		// provider operation_timeout instead
+		createTimeout, diags := testCase.timeouts.Create(ctx, 0)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, diags)
+		}
+
+		timeoutCtx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+		deadline, ok := timeoutCtx.Deadline()
+		cancel()
+
+		if ok != testCase.wantDeadline {
+			t.Errorf("%s: expected deadline=%t, got %t", name, testCase.wantDeadline, ok)
+		}
+		if ok && time.Until(deadline) > 10*time.Minute {
+			t.Errorf("%s: expected a deadline within 10m, got %s", name, deadline)
+		}
+	}
+
+	// Every resource accepts the timeouts of its operations
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "make"}, &metadata)
+
+		var schema resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schema)
+
+		if _, ok := schema.Schema.Blocks["timeouts"]; !ok {
+			t.Errorf("%s: expected a timeouts block", metadata.TypeName)
+		}
+	}
+}
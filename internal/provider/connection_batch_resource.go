@@ -0,0 +1,478 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConnectionBatchResource{}
+var _ resource.ResourceWithConfigValidators = &ConnectionBatchResource{}
+var _ resource.ResourceWithModifyPlan = &ConnectionBatchResource{}
+var _ resource.ResourceWithUpgradeState = &ConnectionBatchResource{}
+
+func NewConnectionBatchResource() resource.Resource {
+	return &ConnectionBatchResource{}
+}
+
+// ConnectionBatchResource defines the resource implementation.
+type ConnectionBatchResource struct {
+	client MakeAPI
+}
+
+// ConnectionBatchResourceModel describes the resource data model.
+type ConnectionBatchResourceModel struct {
+	Id          types.String                        `tfsdk:"id"`
+	AppName     types.String                        `tfsdk:"app_name"`
+	TeamId      types.String                        `tfsdk:"team_id"`
+	Settings    types.Map                           `tfsdk:"settings"`
+	Connections map[string]ConnectionBatchItemModel `tfsdk:"connections"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ConnectionBatchItemModel describes a single connection within the batch.
+type ConnectionBatchItemModel struct {
+	Name         types.String `tfsdk:"name"`
+	Settings     types.Map    `tfsdk:"settings"`
+	ConnectionId types.String `tfsdk:"connection_id"`
+	Verified     types.Bool   `tfsdk:"verified"`
+}
+
+func (r *ConnectionBatchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_batch"
+}
+
+func (r *ConnectionBatchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages many similar Make.com connections from a single template. " +
+			"Each entry in `connections` creates one connection whose settings are the template `settings` " +
+			"overlaid with the entry's own `settings`.",
+
+		Version: 0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Connection batch identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the app for every connection in the batch (e.g., 'gmail', 'slack')",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: appNameValidators(),
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team ID where the connections belong. Defaults to the provider `default_team_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"settings": schema.MapAttribute{
+				MarkdownDescription: "Template settings shared by every connection in the batch",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"connections": schema.MapNestedAttribute{
+				MarkdownDescription: "Connections to create, keyed by an arbitrary instance key (e.g. tenant name)",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the connection",
+							Required:            true,
+							Validators:          nameValidators(),
+						},
+						"settings": schema.MapAttribute{
+							MarkdownDescription: "Settings overriding the template settings for this connection",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+						"connection_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the created connection",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"verified": schema.BoolAttribute{
+							MarkdownDescription: "Whether the connection is verified",
+							Computed:            true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *ConnectionBatchResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *ConnectionBatchResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(path.Root("team_id")),
+	}
+}
+
+func (r *ConnectionBatchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.PlanDefaultIDs(ctx, req, resp, defaultID{Path: path.Root("team_id")})
+
+	r.client.CheckReferences(ctx, resp.Plan, &resp.Diagnostics,
+		reference{Path: path.Root("team_id"), Endpoint: "v2/teams/%s"},
+	)
+}
+
+func (r *ConnectionBatchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		r.client = unconfiguredClient
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ConnectionBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_connection_batch", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data ConnectionBatchResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
+
+	batchId, err := uuid.GenerateUUID()
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to generate connection batch identifier, got error: %s", err))
+		return
+	}
+	data.Id = types.StringValue(batchId)
+
+	// Only connections that were actually created are recorded, so a partial
+	// failure leaves a tainted batch that cleans up after itself
+	created := make(map[string]ConnectionBatchItemModel, len(data.Connections))
+
+	for _, key := range connectionBatchKeys(data.Connections) {
+		item := data.Connections[key]
+
+		apiReq, diags := connectionBatchRequest(ctx, data, item)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			break
+		}
+
+		connection, err := r.client.CreateConnection(ctx, apiReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create connection %q of the batch, got error: %s", key, err))
+			break
+		}
+
+		item.ConnectionId = types.StringValue(connection.ID.String())
+		item.Verified = types.BoolValue(connection.Verified)
+		created[key] = item
+	}
+
+	data.Connections = created
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a connection batch resource", map[string]interface{}{"connections": len(created)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConnectionBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_connection_batch", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data ConnectionBatchResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Connections are read concurrently, bounded by the worker pool
+	keys := connectionBatchKeys(data.Connections)
+	connections := make([]*makeapi.ConnectionResponse, len(keys))
+	err := makeapi.ForEach(ctx, r.client.MaxWorkers(), keys, func(ctx context.Context, i int, key string) error {
+		connection, err := r.client.GetConnection(ctx, data.Connections[key].ConnectionId.ValueString())
+		if err != nil {
+			return fmt.Errorf("connection %q: %w", key, err)
+		}
+		connections[i] = connection
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the connections of the batch, got error: %s", err))
+		return
+	}
+
+	for i, key := range keys {
+		item := data.Connections[key]
+		connection := connections[i]
+
+		// Settings are not refreshed: the remote value is the merge of the
+		// template and the override, which cannot be split back apart
+		item.Name = types.StringValue(connection.Name)
+		item.Verified = types.BoolValue(connection.Verified)
+		data.Connections[key] = item
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConnectionBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer recoverPanic(ctx, "make_connection_batch", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var plan, state ConnectionBatchResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, plan.AuthProfile)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	templateChanged := !plan.TeamId.Equal(state.TeamId) || !plan.Settings.Equal(state.Settings)
+
+	// Start from the prior state so that whatever is not reached because of an
+	// error is still tracked and retried on the next apply
+	result := state
+	result.Connections = make(map[string]ConnectionBatchItemModel, len(state.Connections))
+	for key, item := range state.Connections {
+		result.Connections[key] = item
+	}
+
+	// Remove connections that are no longer part of the batch
+	for _, key := range connectionBatchKeys(state.Connections) {
+		if _, ok := plan.Connections[key]; ok {
+			continue
+		}
+
+		err := r.client.DeleteConnection(ctx, state.Connections[key].ConnectionId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete connection %q of the batch, got error: %s", key, err))
+			resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+			return
+		}
+
+		delete(result.Connections, key)
+	}
+
+	// Create new connections and update the ones whose configuration changed
+	for _, key := range connectionBatchKeys(plan.Connections) {
+		item := plan.Connections[key]
+		prior, exists := state.Connections[key]
+
+		if exists && !templateChanged && item.Name.Equal(prior.Name) && item.Settings.Equal(prior.Settings) {
+			continue
+		}
+
+		apiReq, diags := connectionBatchRequest(ctx, plan, item)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+			return
+		}
+
+		var connection *makeapi.ConnectionResponse
+		var err error
+		if exists {
+			// Only the changed fields are sent
+			priorReq, diags := connectionBatchRequest(ctx, state, prior)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+				return
+			}
+
+			connection, err = r.client.UpdateConnection(ctx, prior.ConnectionId.ValueString(), priorReq, apiReq)
+		} else {
+			connection, err = r.client.CreateConnection(ctx, apiReq)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply connection %q of the batch, got error: %s", key, err))
+			resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+			return
+		}
+
+		item.ConnectionId = types.StringValue(connection.ID.String())
+		item.Verified = types.BoolValue(connection.Verified)
+		result.Connections[key] = item
+	}
+
+	result.TeamId = plan.TeamId
+	result.Settings = plan.Settings
+	result.Timeouts = plan.Timeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *ConnectionBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_connection_batch", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data ConnectionBatchResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete every connection of the batch via API
+	for _, key := range connectionBatchKeys(data.Connections) {
+		err := r.client.DeleteConnection(ctx, data.Connections[key].ConnectionId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete connection %q of the batch, got error: %s", key, err))
+			return
+		}
+	}
+}
+
+// connectionBatchKeys returns the instance keys of a batch in a stable order
+func connectionBatchKeys(connections map[string]ConnectionBatchItemModel) []string {
+	keys := make([]string, 0, len(connections))
+	for key := range connections {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// connectionBatchRequest builds the API request for a single batch item by
+// layering the item's settings over the batch template settings
+func connectionBatchRequest(ctx context.Context, data ConnectionBatchResourceModel, item ConnectionBatchItemModel) (makeapi.ConnectionRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiReq := makeapi.ConnectionRequest{
+		Name:    item.Name.ValueString(),
+		AppName: data.AppName.ValueString(),
+	}
+
+	if !data.TeamId.IsNull() {
+		apiReq.TeamID = data.TeamId.ValueString()
+	}
+
+	for _, settings := range []types.Map{data.Settings, item.Settings} {
+		if settings.IsNull() {
+			continue
+		}
+
+		var settingsMap map[string]string
+		diags.Append(settings.ElementsAs(ctx, &settingsMap, false)...)
+		if diags.HasError() {
+			return apiReq, diags
+		}
+
+		if apiReq.Settings == nil {
+			apiReq.Settings = make(map[string]interface{}, len(settingsMap))
+		}
+		for k, v := range settingsMap {
+			apiReq.Settings[k] = v
+		}
+	}
+
+	return apiReq, diags
+}
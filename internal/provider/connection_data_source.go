@@ -19,7 +19,7 @@ func NewConnectionDataSource() datasource.DataSource {
 
 // ConnectionDataSource defines the data source implementation.
 type ConnectionDataSource struct {
-	client *MakeAPIClient
+	client MakeAPI
 }
 
 // ConnectionDataSourceModel describes the data source data model.
@@ -30,6 +30,8 @@ type ConnectionDataSourceModel struct {
 	TeamId   types.String `tfsdk:"team_id"`
 	Verified types.Bool   `tfsdk:"verified"`
 	Settings types.Map    `tfsdk:"settings"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
 }
 
 func (d *ConnectionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -67,6 +69,7 @@ func (d *ConnectionDataSource) Schema(ctx context.Context, req datasource.Schema
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"auth_profile": authProfileDataSourceAttribute(),
 		},
 	}
 }
@@ -77,12 +80,12 @@ func (d *ConnectionDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*MakeAPIClient)
+	client, ok := req.ProviderData.(MakeAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *MakeAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -92,6 +95,9 @@ func (d *ConnectionDataSource) Configure(ctx context.Context, req datasource.Con
 }
 
 func (d *ConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_connection", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
 	var data ConnectionDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -101,6 +107,8 @@ func (d *ConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
 	// Get the connection from the API
 	connection, err := d.client.GetConnection(ctx, data.Id.ValueString())
 	if err != nil {
@@ -109,13 +117,13 @@ func (d *ConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	// Map API response to Terraform state
-	data.Id = types.StringValue(connection.ID)
+	data.Id = types.StringValue(connection.ID.String())
 	data.Name = types.StringValue(connection.Name)
 	data.AppName = types.StringValue(connection.AppName)
 	data.Verified = types.BoolValue(connection.Verified)
 
 	if connection.TeamID != "" {
-		data.TeamId = types.StringValue(connection.TeamID)
+		data.TeamId = types.StringValue(connection.TeamID.String())
 	} else {
 		data.TeamId = types.StringNull()
 	}
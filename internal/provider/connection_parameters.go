@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Connections of apps such as http, google, slack or the database apps take
+// typed parameters, e.g. { url = "https://example.com", port = 5432 }, next to
+// the string map of settings. During plan they are checked against the
+// parameters of the connection of the app in the apps catalog of Make, so that
+// a misspelled, missing or mistyped parameter fails before the connection is
+// created. Apps missing from the catalog, e.g. custom apps, are not checked.
+
+// connectionParametersPath is the path of the parameters of make_connection
+var connectionParametersPath = path.Root("parameters")
+
+// CheckConnectionParameters adds an error to diags for each parameter of the
+// planned connection not matching the connection of its app in the catalog
+func (c *apiClient) CheckConnectionParameters(ctx context.Context, plan attributeGetter, diags *diag.Diagnostics) {
+	if c == nil {
+		return
+	}
+
+	var appName, profile types.String
+	var parameters types.Dynamic
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("app_name"), &appName)...)
+	diags.Append(plan.GetAttribute(ctx, connectionParametersPath, &parameters)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("auth_profile"), &profile)...)
+
+	if diags.HasError() {
+		return
+	}
+
+	attributes, ok := dynamicAttributes(parameters)
+	if !ok || appName.IsNull() || appName.IsUnknown() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, profile)
+
+	connection, err := c.GetAppConnection(ctx, appName.ValueString())
+	if makeapi.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		diags.AddAttributeWarning(
+			connectionParametersPath,
+			"Unable to Validate Connection Parameters",
+			fmt.Sprintf("Could not read the parameters of the %s app connection, got error: %s", appName.ValueString(), err),
+		)
+		return
+	}
+
+	diags.Append(validateConnectionParameters(connectionParametersPath, appName.ValueString(), connection.Parameters, attributes)...)
+}
+
+// dynamicAttributes returns the attributes of a known dynamic object or map
+func dynamicAttributes(value types.Dynamic) (map[string]attr.Value, bool) {
+	if value.IsNull() || value.IsUnknown() || value.IsUnderlyingValueNull() || value.IsUnderlyingValueUnknown() {
+		return nil, false
+	}
+
+	switch v := value.UnderlyingValue().(type) {
+	case basetypes.ObjectValue:
+		return v.Attributes(), true
+	case basetypes.MapValue:
+		return v.Elements(), true
+	default:
+		return nil, false
+	}
+}
+
+// validateConnectionParameters checks parameters against the parameters an app
+// connection takes: each must be known to the app and of its type, and
+// required ones must be set. Values not known yet are not checked.
+func validateConnectionParameters(p path.Path, appName string, params []makeapi.AppParameter, parameters map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	byName := make(map[string]makeapi.AppParameter, len(params))
+	for _, param := range params {
+		byName[param.Name] = param
+	}
+
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		param, ok := byName[name]
+		if !ok {
+			diags.AddAttributeError(
+				p.AtName(name),
+				"Unknown Connection Parameter",
+				fmt.Sprintf("The %s app connection takes no parameter %q. Valid parameters are: %s.",
+					appName, name, strings.Join(sortedStrings(parameterNames(params)), ", ")),
+			)
+			continue
+		}
+
+		if err := checkParameterValue(param, parameters[name]); err != nil {
+			diags.AddAttributeError(
+				p.AtName(name),
+				"Invalid Connection Parameter",
+				fmt.Sprintf("Parameter %q of the %s app connection %s.", name, appName, err),
+			)
+		}
+	}
+
+	for _, param := range params {
+		if value, ok := parameters[param.Name]; param.Required && (!ok || value.IsNull()) {
+			label := param.Name
+			if param.Label != "" {
+				label = fmt.Sprintf("%s (%s)", param.Name, param.Label)
+			}
+
+			diags.AddAttributeError(
+				p,
+				"Missing Connection Parameter",
+				fmt.Sprintf("The %s app connection requires the parameter %s.", appName, label),
+			)
+		}
+	}
+
+	return diags
+}
+
+// parameterNames returns the names of app parameters
+func parameterNames(params []makeapi.AppParameter) []string {
+	names := make([]string, 0, len(params))
+	for _, param := range params {
+		names = append(names, param.Name)
+	}
+	return names
+}
+
+// checkParameterValue returns an error telling what is wrong when value does
+// not fit the type of param. Null and unknown values are not checked.
+func checkParameterValue(param makeapi.AppParameter, value attr.Value) error {
+	converted, err := dynamicValueToJSON(value)
+	if err != nil || converted == nil {
+		return nil
+	}
+
+	switch param.Type {
+	case "number", "integer", "uinteger":
+		number, ok := converted.(json.Number)
+		if !ok {
+			return fmt.Errorf("must be a number")
+		}
+
+		parsed, _, err := big.ParseFloat(number.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return fmt.Errorf("must be a number")
+		}
+		if param.Type != "number" && !parsed.IsInt() {
+			return fmt.Errorf("must be a whole number")
+		}
+		if param.Type == "uinteger" && parsed.Sign() < 0 {
+			return fmt.Errorf("must not be negative")
+		}
+	case "boolean":
+		if _, ok := converted.(bool); !ok {
+			return fmt.Errorf("must be a boolean")
+		}
+	case "array":
+		if _, ok := converted.([]interface{}); !ok {
+			return fmt.Errorf("must be a list")
+		}
+	case "collection":
+		if _, ok := converted.(map[string]interface{}); !ok {
+			return fmt.Errorf("must be an object")
+		}
+	case "select":
+		selected, ok := converted.(string)
+		if !ok {
+			return fmt.Errorf("must be a string")
+		}
+		if len(param.Options) == 0 {
+			return nil
+		}
+
+		values := make([]string, 0, len(param.Options))
+		for _, option := range param.Options {
+			if option.Value == selected {
+				return nil
+			}
+			values = append(values, fmt.Sprintf("%q", option.Value))
+		}
+		return fmt.Errorf("must be one of %s, got %q", strings.Join(values, ", "), selected)
+	default:
+		// text, password, email, url and the other parameter types holding text
+		if _, ok := converted.(string); !ok {
+			return fmt.Errorf("must be a string")
+		}
+	}
+
+	return nil
+}
+
+// connectionParameters converts the configured parameters of a connection into
+// the JSON object sent to the API
+func connectionParameters(value types.Dynamic) (map[string]interface{}, error) {
+	attributes, ok := dynamicAttributes(value)
+	if !ok {
+		return nil, nil
+	}
+
+	return dynamicAttributesToJSON(attributes)
+}
+
+// connectionParametersValue returns the parameters of a connection to store in
+// state. Only the configured parameters are refreshed: those the API leaves
+// out, e.g. because they are secret, keep their prior value, and so does a
+// refresh without changes, which keeps the configured types.
+func connectionParametersValue(prior types.Dynamic, remote map[string]interface{}) (types.Dynamic, error) {
+	configured, err := connectionParameters(prior)
+	if err != nil || configured == nil || len(remote) == 0 {
+		return prior, err
+	}
+
+	refreshed := make(map[string]interface{}, len(configured))
+	for name, value := range configured {
+		refreshed[name] = value
+		if remoteValue, ok := remote[name]; ok {
+			refreshed[name] = remoteValue
+		}
+	}
+
+	priorJSON, _ := json.Marshal(configured)
+	refreshedJSON, _ := json.Marshal(refreshed)
+	if jsonEqual(string(priorJSON), string(refreshedJSON)) {
+		return prior, nil
+	}
+
+	value, err := dynamicValueFromJSON(refreshed)
+	if err != nil {
+		return prior, err
+	}
+
+	return types.DynamicValue(value), nil
+}
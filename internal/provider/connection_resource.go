@@ -2,20 +2,30 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ConnectionResource{}
 var _ resource.ResourceWithImportState = &ConnectionResource{}
+var _ resource.ResourceWithConfigValidators = &ConnectionResource{}
+var _ resource.ResourceWithModifyPlan = &ConnectionResource{}
+var _ resource.ResourceWithUpgradeState = &ConnectionResource{}
+var _ resource.ResourceWithIdentity = &ConnectionResource{}
 
 func NewConnectionResource() resource.Resource {
 	return &ConnectionResource{}
@@ -23,17 +33,21 @@ func NewConnectionResource() resource.Resource {
 
 // ConnectionResource defines the resource implementation.
 type ConnectionResource struct {
-	client *MakeAPIClient
+	client MakeAPI
 }
 
 // ConnectionResourceModel describes the resource data model.
 type ConnectionResourceModel struct {
-	Id       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	AppName  types.String `tfsdk:"app_name"`
-	TeamId   types.String `tfsdk:"team_id"`
-	Settings types.Map    `tfsdk:"settings"`
-	Verified types.Bool   `tfsdk:"verified"`
+	Id         types.String  `tfsdk:"id"`
+	Name       types.String  `tfsdk:"name"`
+	AppName    types.String  `tfsdk:"app_name"`
+	TeamId     types.String  `tfsdk:"team_id"`
+	Settings   types.Map     `tfsdk:"settings"`
+	Parameters types.Dynamic `tfsdk:"parameters"`
+	Verified   types.Bool    `tfsdk:"verified"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -45,6 +59,8 @@ func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Make.com connection resource",
 
+		Version: 0,
+
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -56,40 +72,106 @@ func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the connection",
 				Required:            true,
+				Validators:          nameValidators(),
 			},
 			"app_name": schema.StringAttribute{
 				MarkdownDescription: "Name of the app for this connection (e.g., 'gmail', 'slack')",
 				Required:            true,
+				Validators:          appNameValidators(),
 			},
 			"team_id": schema.StringAttribute{
-				MarkdownDescription: "Team ID where the connection belongs",
-				Optional:            true,
+				MarkdownDescription: "Team ID where the connection belongs. Defaults to the provider `default_team_id`. " +
+					"Changing the team forces a new connection to be created.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					requiresReplaceOwner("Make cannot move a connection to another team, so changing the team replaces it."),
+				},
 			},
 			"settings": schema.MapAttribute{
 				MarkdownDescription: "Advanced settings for the connection",
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"parameters": schema.DynamicAttribute{
+				MarkdownDescription: "Typed parameters of the connection as an object, e.g. `{ url = \"https://example.com\", " +
+					"port = 5432 }`, keeping numbers, booleans, lists and nested objects as such. During plan they are checked " +
+					"against the parameters the app connection takes in the apps catalog of Make; apps missing from the " +
+					"catalog, e.g. custom apps, are not checked. Only the configured parameters are refreshed, and those " +
+					"Make does not return, e.g. secrets, keep their configured value.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.Dynamic{
+					dynamicObject(),
+				},
+			},
 			"verified": schema.BoolAttribute{
 				MarkdownDescription: "Whether the connection is verified",
 				Computed:            true,
 			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *ConnectionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *ConnectionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Connection identifier",
+				RequiredForImport: true,
+			},
+			"team_id": identityschema.StringAttribute{
+				Description:       "Team ID where the connection belongs",
+				OptionalForImport: true,
+			},
 		},
 	}
 }
 
+func (r *ConnectionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(path.Root("team_id")),
+	}
+}
+
+func (r *ConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.PlanDefaultIDs(ctx, req, resp, defaultID{Path: path.Root("team_id")})
+
+	r.client.CheckReferences(ctx, resp.Plan, &resp.Diagnostics,
+		reference{Path: path.Root("team_id"), Endpoint: "v2/teams/%s"},
+	)
+
+	r.client.CheckConnectionParameters(ctx, resp.Plan, &resp.Diagnostics)
+}
+
 func (r *ConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
+		r.client = unconfiguredClient
 		return
 	}
 
-	client, ok := req.ProviderData.(*MakeAPIClient)
+	client, ok := req.ProviderData.(MakeAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *MakeAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -99,6 +181,10 @@ func (r *ConnectionResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_connection", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data ConnectionResourceModel
 
 	// Read Terraform plan data into the model
@@ -108,49 +194,51 @@ func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	// Prepare the API request
-	apiReq := ConnectionRequest{
-		Name:    data.Name.ValueString(),
-		AppName: data.AppName.ValueString(),
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.TeamId.IsNull() {
-		apiReq.TeamID = data.TeamId.ValueString()
-	}
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
 
-	if !data.Settings.IsNull() {
-		var settingsMap map[string]string
-		resp.Diagnostics.Append(data.Settings.ElementsAs(ctx, &settingsMap, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		apiReq.Settings = make(map[string]interface{}, len(settingsMap))
-		for k, v := range settingsMap {
-			apiReq.Settings[k] = v
-		}
+	// Prepare the API request
+	apiReq, diags := connectionRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Create the connection via API
+	ctx, etags := makeapi.WithETagRecorder(ctx)
 	connection, err := r.client.CreateConnection(ctx, apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create connection, got error: %s", err))
 		return
 	}
 
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag()})...)
+
 	// Map response to Terraform state
-	data.Id = types.StringValue(connection.ID)
+	data.Id = types.StringValue(connection.ID.String())
 	data.Name = types.StringValue(connection.Name)
 	data.AppName = types.StringValue(connection.AppName)
 	data.Verified = types.BoolValue(connection.Verified)
 
 	if connection.TeamID != "" {
-		data.TeamId = types.StringValue(connection.TeamID)
+		data.TeamId = types.StringValue(connection.TeamID.String())
 	}
 
 	if len(connection.Settings) > 0 {
 		data.Settings = types.MapValueMust(types.StringType, convertSettingsToStringMap(connection.Settings))
 	}
 
+	// The planned parameters are kept, as Make may echo them in another form,
+	// e.g. numbers as strings. Read refreshes them.
+
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "created a connection resource")
 
@@ -159,6 +247,10 @@ func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_connection", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data ConnectionResourceModel
 
 	// Read Terraform prior state data into the model
@@ -168,23 +260,45 @@ func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the connection from the API
+	ctx, etags := makeapi.WithETagRecorder(ctx)
 	connection, err := r.client.GetConnection(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read connection, got error: %s", err))
 		return
 	}
 
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag()})...)
+
+	// Surface connections that lost their verification (e.g. a revoked token)
+	// before scenarios using them start failing
+	if data.Verified.ValueBool() && !connection.Verified {
+		resp.Diagnostics.AddWarning(
+			"Connection No Longer Verified",
+			fmt.Sprintf("Connection %q (ID %s) was previously verified but Make now reports it as unverified. "+
+				"Its credentials may have been revoked or expired; scenarios using this connection are likely to fail "+
+				"until it is reauthorized in Make.", connection.Name, connection.ID),
+		)
+	}
+
 	// Map API response to Terraform state
-	data.Id = types.StringValue(connection.ID)
+	data.Id = types.StringValue(connection.ID.String())
 	data.Name = types.StringValue(connection.Name)
 	data.AppName = types.StringValue(connection.AppName)
 	data.Verified = types.BoolValue(connection.Verified)
 
 	if connection.TeamID != "" {
-		data.TeamId = types.StringValue(connection.TeamID)
-	} else {
-		data.TeamId = types.StringNull()
+		data.TeamId = types.StringValue(connection.TeamID.String())
 	}
 
 	if len(connection.Settings) > 0 {
@@ -193,57 +307,85 @@ func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.Settings = types.MapNull(types.StringType)
 	}
 
+	data.Parameters, err = connectionParametersValue(data.Parameters, connection.Parameters)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read connection parameters, got error: %s", err))
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ConnectionResourceModel
+	defer recoverPanic(ctx, "make_connection", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
 
-	// Read Terraform plan data into the model
+	var data, prior ConnectionResourceModel
+
+	// Read Terraform plan data and prior state into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Prepare the API request
-	apiReq := ConnectionRequest{
-		Name:    data.Name.ValueString(),
-		AppName: data.AppName.ValueString(),
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.TeamId.IsNull() {
-		apiReq.TeamID = data.TeamId.ValueString()
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Prepare the API request, which only sends the changed fields
+	apiReq, diags := connectionRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	priorReq, diags := connectionRequest(ctx, prior)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Settings.IsNull() {
-		var settingsMap map[string]string
-		resp.Diagnostics.Append(data.Settings.ElementsAs(ctx, &settingsMap, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		apiReq.Settings = make(map[string]interface{}, len(settingsMap))
-		for k, v := range settingsMap {
-			apiReq.Settings[k] = v
-		}
+	// Refuse to overwrite changes made since Terraform last read the connection
+	version, diags := getObjectVersion(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Update the connection via API
-	connection, err := r.client.UpdateConnection(ctx, data.Id.ValueString(), apiReq)
+	ctx = makeapi.WithIfMatch(ctx, version.ETag)
+	ctx, etags := makeapi.WithETagRecorder(ctx)
+	connection, err := r.client.UpdateConnection(ctx, data.Id.ValueString(), priorReq, apiReq)
 	if err != nil {
+		var conflict *makeapi.ConflictError
+		if errors.As(err, &conflict) {
+			addObjectChangedError(&resp.Diagnostics, "connection", data.Id.ValueString())
+			return
+		}
+
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update connection, got error: %s", err))
 		return
 	}
 
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag()})...)
+
 	// Map response to Terraform state
-	data.Id = types.StringValue(connection.ID)
+	data.Id = types.StringValue(connection.ID.String())
 	data.Name = types.StringValue(connection.Name)
 	data.AppName = types.StringValue(connection.AppName)
 	data.Verified = types.BoolValue(connection.Verified)
 
 	if connection.TeamID != "" {
-		data.TeamId = types.StringValue(connection.TeamID)
+		data.TeamId = types.StringValue(connection.TeamID.String())
 	} else {
 		data.TeamId = types.StringNull()
 	}
@@ -254,11 +396,17 @@ func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		data.Settings = types.MapNull(types.StringType)
 	}
 
+	// The planned parameters are kept, as Make may echo them in another form,
+	// e.g. numbers as strings. Read refreshes them.
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_connection", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
 	var data ConnectionResourceModel
 
 	// Read Terraform prior state data into the model
@@ -268,6 +416,16 @@ func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the connection via API
 	err := r.client.DeleteConnection(ctx, data.Id.ValueString())
 	if err != nil {
@@ -277,6 +435,57 @@ func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *ConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "team_id", "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// name=<name> imports the connection of that name
+	id, err := r.client.ResolveImportName(ctx, req.ID, "connection", importCandidates(MakeAPI.ListConnections, func(connection makeapi.ConnectionResponse) importCandidate {
+		return importCandidate{ID: connection.ID.String(), Name: connection.Name}
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Import by Name", err.Error())
+		return
+	}
+
+	// The ID may be prefixed by the team ID, e.g. <team_id>/<id>
+	importScopedID(ctx, id, path.Root("team_id"), resp)
+}
+
+// connectionRequest returns the API request for a connection model
+func connectionRequest(ctx context.Context, data ConnectionResourceModel) (makeapi.ConnectionRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiReq := makeapi.ConnectionRequest{
+		Name:    data.Name.ValueString(),
+		AppName: data.AppName.ValueString(),
+	}
+
+	if !data.TeamId.IsNull() {
+		apiReq.TeamID = data.TeamId.ValueString()
+	}
+
+	if !data.Settings.IsNull() {
+		var settingsMap map[string]string
+		diags.Append(data.Settings.ElementsAs(ctx, &settingsMap, false)...)
+		if diags.HasError() {
+			return apiReq, diags
+		}
+		apiReq.Settings = make(map[string]interface{}, len(settingsMap))
+		for k, v := range settingsMap {
+			apiReq.Settings[k] = v
+		}
+	}
+
+	parameters, err := connectionParameters(data.Parameters)
+	if err != nil {
+		diags.AddAttributeError(path.Root("parameters"), "Invalid Parameters", fmt.Sprintf("Unable to convert the parameters, got error: %s", err))
+		return apiReq, diags
+	}
+	apiReq.Parameters = parameters
+
+	return apiReq, diags
 }
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// tokenCommandTimeout bounds how long a credential helper may take to print
+// the API token
+const tokenCommandTimeout = 30 * time.Second
+
+// readTokenFile returns the API token stored in a file, e.g. a mounted
+// secret. Surrounding whitespace such as a trailing newline is ignored.
+func readTokenFile(name string) (string, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", fmt.Errorf("failed to read API token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("API token file %s is empty", name)
+	}
+
+	return token, nil
+}
+
+// runTokenCommand runs a credential helper and returns the API token it
+// prints to standard output. The command is run directly, without a shell.
+func runTokenCommand(ctx context.Context, args []string) (string, error) {
+	if len(args) == 0 || args[0] == "" {
+		return "", fmt.Errorf("API token command is empty")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, tokenCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("API token command failed: %w: %s", err, message)
+		}
+		return "", fmt.Errorf("API token command failed: %w", err)
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("API token command printed no token")
+	}
+
+	return token, nil
+}
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomAppInviteResource{}
+var _ resource.ResourceWithImportState = &CustomAppInviteResource{}
+var _ resource.ResourceWithConfigValidators = &CustomAppInviteResource{}
+var _ resource.ResourceWithModifyPlan = &CustomAppInviteResource{}
+var _ resource.ResourceWithUpgradeState = &CustomAppInviteResource{}
+var _ resource.ResourceWithIdentity = &CustomAppInviteResource{}
+
+func NewCustomAppInviteResource() resource.Resource {
+	return &CustomAppInviteResource{}
+}
+
+// CustomAppInviteResource defines the resource implementation.
+type CustomAppInviteResource struct {
+	client MakeAPI
+}
+
+// CustomAppInviteResourceModel describes the resource data model.
+type CustomAppInviteResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	AppName        types.String `tfsdk:"app_name"`
+	AppVersion     types.Int64  `tfsdk:"app_version"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *CustomAppInviteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_app_invite"
+}
+
+func (r *CustomAppInviteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Invitation for another Make.com organization to install a published custom app " +
+			"version. Destroying the resource revokes the invitation.",
+
+		Version: 0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Invitation identifier in the form `<app_name>/<app_version>/<organization_id>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the custom app",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(customAppNamePattern, "must start with a lowercase letter and contain only lowercase letters, digits and hyphens"),
+				},
+			},
+			"app_version": schema.Int64Attribute{
+				MarkdownDescription: "Major version of the custom app",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization invited to install the app. Defaults to the provider `default_organization_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation time of the invitation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *CustomAppInviteResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *CustomAppInviteResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"app_name": identityschema.StringAttribute{
+				Description:       "Name of the custom app",
+				RequiredForImport: true,
+			},
+			"app_version": identityschema.Int64Attribute{
+				Description:       "Major version of the custom app",
+				RequiredForImport: true,
+			},
+			"organization_id": identityschema.StringAttribute{
+				Description:       "ID of the organization invited to install the app",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *CustomAppInviteResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(path.Root("organization_id")),
+	}
+}
+
+func (r *CustomAppInviteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.PlanDefaultIDs(ctx, req, resp, defaultID{Path: path.Root("organization_id"), Organization: true, Required: true})
+
+	r.client.CheckReferences(ctx, resp.Plan, &resp.Diagnostics,
+		reference{Path: path.Root("organization_id"), Endpoint: "v2/organizations/%s"},
+	)
+}
+
+func (r *CustomAppInviteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		r.client = unconfiguredClient
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomAppInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_custom_app_invite", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data CustomAppInviteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Invite the organization via API
+	invite, err := r.client.CreateCustomAppInvite(ctx, data.AppName.ValueString(), data.AppVersion.ValueInt64(), data.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom app invite, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.Id = types.StringValue(customAppInviteID(data.AppName.ValueString(), data.AppVersion.ValueInt64(), data.OrganizationId.ValueString()))
+	data.CreatedAt = types.StringValue(invite.CreatedAt)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a custom app invite resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomAppInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_custom_app_invite", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data CustomAppInviteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the invitation from the API
+	invite, err := r.client.GetCustomAppInvite(ctx, data.AppName.ValueString(), data.AppVersion.ValueInt64(), data.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom app invite, got error: %s", err))
+		return
+	}
+
+	// Map API response to Terraform state
+	data.Id = types.StringValue(customAppInviteID(data.AppName.ValueString(), data.AppVersion.ValueInt64(), data.OrganizationId.ValueString()))
+	data.CreatedAt = types.StringValue(invite.CreatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomAppInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer recoverPanic(ctx, "make_custom_app_invite", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data CustomAppInviteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Every configurable attribute requires replacement, so there is nothing
+	// to send to the API
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomAppInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_custom_app_invite", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data CustomAppInviteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Revoke the invitation via API
+	err := r.client.DeleteCustomAppInvite(ctx, data.AppName.ValueString(), data.AppVersion.ValueInt64(), data.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom app invite, got error: %s", err))
+		return
+	}
+}
+
+func (r *CustomAppInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "app_name", "app_version", "organization_id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := strings.Split(req.ID, "/")
+	var version int64
+	var err error
+	if len(parts) == 3 {
+		version, err = strconv.ParseInt(parts[1], 10, 64)
+	}
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" || err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <app_name>/<app_version>/<organization_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_version"), version)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[2])...)
+}
+
+// customAppInviteID builds the identifier of a custom app invitation
+func customAppInviteID(appName string, appVersion int64, organizationID string) string {
+	return fmt.Sprintf("%s/%s", customAppID(appName, appVersion), organizationID)
+}
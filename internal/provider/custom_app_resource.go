@@ -0,0 +1,633 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomAppResource{}
+var _ resource.ResourceWithImportState = &CustomAppResource{}
+var _ resource.ResourceWithModifyPlan = &CustomAppResource{}
+var _ resource.ResourceWithUpgradeState = &CustomAppResource{}
+var _ resource.ResourceWithIdentity = &CustomAppResource{}
+
+// customAppNamePattern matches the names Make.com accepts for custom apps
+var customAppNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// customAppThemePattern matches hex color codes such as #1a2b3c
+var customAppThemePattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func NewCustomAppResource() resource.Resource {
+	return &CustomAppResource{}
+}
+
+// CustomAppResource defines the resource implementation.
+type CustomAppResource struct {
+	client MakeAPI
+}
+
+// CustomAppResourceModel describes the resource data model.
+type CustomAppResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Version     types.Int64  `tfsdk:"version"`
+	Label       types.String `tfsdk:"label"`
+	Description types.String `tfsdk:"description"`
+	Theme       types.String `tfsdk:"theme"`
+
+	Base       jsontypes.Normalized `tfsdk:"base"`
+	CommonData jsontypes.Normalized `tfsdk:"common_data"`
+	Groups     jsontypes.Normalized `tfsdk:"groups"`
+
+	Icon     types.String `tfsdk:"icon"`
+	IconHash types.String `tfsdk:"icon_hash"`
+
+	Published types.Bool `tfsdk:"published"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// customAppSectionValue pairs a JSON section of a custom app with the model
+// attribute holding it
+type customAppSectionValue struct {
+	section string
+	value   *jsontypes.Normalized
+}
+
+// sections returns the JSON sections of the custom app held by the model
+func (m *CustomAppResourceModel) sections() []customAppSectionValue {
+	return []customAppSectionValue{
+		{section: makeapi.CustomAppSectionBase, value: &m.Base},
+		{section: makeapi.CustomAppSectionCommon, value: &m.CommonData},
+		{section: makeapi.CustomAppSectionGroups, value: &m.Groups},
+	}
+}
+
+func (r *CustomAppResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_app"
+}
+
+func (r *CustomAppResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Make.com custom app resource, managing the shell of a private app built with the " +
+			"Make.com SDK. Each version of an app is a separate resource.",
+
+		Version: 0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Custom app identifier in the form `<name>/<version>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the app used in the app's URLs, made of lowercase letters, digits and hyphens",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(customAppNamePattern, "must start with a lowercase letter and contain only lowercase letters, digits and hyphens"),
+				},
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Major version of the app. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Display name of the app",
+				Required:            true,
+				Validators:          nameValidators(),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the app",
+				Optional:            true,
+			},
+			"theme": schema.StringAttribute{
+				MarkdownDescription: "Theme color of the app as a hex color code, e.g. `#6e2bc5`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(customAppThemePattern, "must be a hex color code such as #6e2bc5"),
+				},
+			},
+			"base": schema.StringAttribute{
+				MarkdownDescription: "Base section of the app as a JSON string, holding the base URL, headers and " +
+					"error handling shared by all modules. Key order and formatting are ignored. The section is " +
+					"left untouched when omitted.",
+				Optional:   true,
+				CustomType: jsontypes.NormalizedType{},
+				Validators: []validator.String{
+					jsonObject(),
+				},
+			},
+			"common_data": schema.StringAttribute{
+				MarkdownDescription: "Common data of the app as a JSON string, e.g. OAuth client credentials shared by " +
+					"all connections. Key order and formatting are ignored. The section is left untouched when omitted.",
+				Optional:   true,
+				Sensitive:  true,
+				CustomType: jsontypes.NormalizedType{},
+				Validators: []validator.String{
+					jsonObject(),
+				},
+			},
+			"groups": schema.StringAttribute{
+				MarkdownDescription: "Module groups of the app as a JSON string, controlling how modules are grouped " +
+					"in the scenario editor. Key order and formatting are ignored. The section is left untouched " +
+					"when omitted.",
+				Optional:   true,
+				CustomType: jsontypes.NormalizedType{},
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the app as a path to a PNG file or as base64-encoded PNG content, e.g. " +
+					"`filebase64(\"icon.png\")`. Changes to the file content are detected through `icon_hash`. " +
+					"The icon is left untouched when omitted.",
+				Optional: true,
+			},
+			"icon_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the uploaded icon",
+				Computed:            true,
+			},
+			"published": schema.BoolAttribute{
+				MarkdownDescription: "Whether the app version is published, so that it can be installed by the " +
+					"organizations invited with `make_custom_app_invite`. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *CustomAppResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *CustomAppResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				Description:       "Name of the app",
+				RequiredForImport: true,
+			},
+			"version": identityschema.Int64Attribute{
+				Description:       "Major version of the app",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *CustomAppResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var icon types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("icon"), &icon)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Hash the icon content so that changes to the file behind an unchanged
+	// path are detected
+	iconHash := types.StringUnknown()
+	switch {
+	case icon.IsNull():
+		iconHash = types.StringNull()
+	case !icon.IsUnknown():
+		content, err := loadCustomAppIcon(icon.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("icon"), "Invalid Custom App Icon", err.Error())
+			return
+		}
+		iconHash = types.StringValue(customAppIconHash(content))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("icon_hash"), iconHash)...)
+}
+
+func (r *CustomAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_custom_app", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data CustomAppResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Prepare the API request
+	apiReq := makeapi.CustomAppRequest{
+		Name:    data.Name.ValueString(),
+		Label:   data.Label.ValueString(),
+		Version: data.Version.ValueInt64(),
+	}
+
+	if !data.Description.IsNull() {
+		apiReq.Description = data.Description.ValueString()
+	}
+
+	if !data.Theme.IsUnknown() && !data.Theme.IsNull() {
+		apiReq.Theme = data.Theme.ValueString()
+	}
+
+	// Create the custom app via API
+	app, err := r.client.CreateCustomApp(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom app, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.Id = types.StringValue(customAppID(app.Name, app.Version))
+	data.Name = types.StringValue(app.Name)
+	data.Version = types.Int64Value(app.Version)
+	data.Label = types.StringValue(app.Label)
+
+	if app.Description != "" {
+		data.Description = types.StringValue(app.Description)
+	}
+
+	data.Theme = types.StringValue(app.Theme)
+
+	// Write the configured sections via API
+	for _, s := range data.sections() {
+		if s.value.IsNull() {
+			continue
+		}
+
+		err := r.client.SetCustomAppSection(ctx, app.Name, app.Version, s.section, json.RawMessage(s.value.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set %s section of custom app, got error: %s", s.section, err))
+			return
+		}
+	}
+
+	// Upload the icon via API
+	if !data.Icon.IsNull() {
+		hash, err := r.uploadIcon(ctx, app.Name, app.Version, data.Icon.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload custom app icon, got error: %s", err))
+			return
+		}
+		data.IconHash = types.StringValue(hash)
+	} else {
+		data.IconHash = types.StringNull()
+	}
+
+	// Publish the app version once it is complete
+	if data.Published.ValueBool() {
+		if err := r.client.SetCustomAppPublished(ctx, app.Name, app.Version, true); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to publish custom app, got error: %s", err))
+			return
+		}
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a custom app resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_custom_app", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data CustomAppResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the custom app from the API
+	app, err := r.client.GetCustomApp(ctx, data.Name.ValueString(), data.Version.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom app, got error: %s", err))
+		return
+	}
+
+	// Map API response to Terraform state
+	data.Id = types.StringValue(customAppID(app.Name, app.Version))
+	data.Name = types.StringValue(app.Name)
+	data.Version = types.Int64Value(app.Version)
+	data.Label = types.StringValue(app.Label)
+
+	if app.Description != "" {
+		data.Description = types.StringValue(app.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	data.Theme = types.StringValue(app.Theme)
+	data.Published = types.BoolValue(app.Public)
+
+	// Only the sections managed by Terraform are refreshed, keeping the value
+	// in state when it is semantically equal
+	for _, s := range data.sections() {
+		if s.value.IsNull() {
+			continue
+		}
+
+		content, err := r.client.GetCustomAppSection(ctx, app.Name, app.Version, s.section)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s section of custom app, got error: %s", s.section, err))
+			return
+		}
+
+		*s.value = jsontypes.NewNormalizedValue(string(content))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer recoverPanic(ctx, "make_custom_app", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data, state CustomAppResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Prepare the API request. Name and version require replacement.
+	apiReq := makeapi.CustomAppRequest{
+		Label: data.Label.ValueString(),
+	}
+
+	if !data.Description.IsNull() {
+		apiReq.Description = data.Description.ValueString()
+	}
+
+	if !data.Theme.IsUnknown() && !data.Theme.IsNull() {
+		apiReq.Theme = data.Theme.ValueString()
+	}
+
+	// Update the custom app via API
+	app, err := r.client.UpdateCustomApp(ctx, data.Name.ValueString(), data.Version.ValueInt64(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom app, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.Label = types.StringValue(app.Label)
+
+	if app.Description != "" {
+		data.Description = types.StringValue(app.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	data.Theme = types.StringValue(app.Theme)
+
+	// Write the sections that changed via API
+	priorSections := state.sections()
+	for i, s := range data.sections() {
+		if s.value.IsNull() || s.value.Equal(*priorSections[i].value) {
+			continue
+		}
+
+		err := r.client.SetCustomAppSection(ctx, app.Name, app.Version, s.section, json.RawMessage(s.value.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set %s section of custom app, got error: %s", s.section, err))
+			return
+		}
+	}
+
+	// Upload the icon via API when its content changed
+	if !data.Icon.IsNull() && !data.IconHash.Equal(state.IconHash) {
+		hash, err := r.uploadIcon(ctx, app.Name, app.Version, data.Icon.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload custom app icon, got error: %s", err))
+			return
+		}
+		data.IconHash = types.StringValue(hash)
+	}
+
+	// Publish or unpublish the app version
+	if !data.Published.Equal(state.Published) {
+		if err := r.client.SetCustomAppPublished(ctx, app.Name, app.Version, data.Published.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change publication of custom app, got error: %s", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_custom_app", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data CustomAppResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the custom app via API
+	err := r.client.DeleteCustomApp(ctx, data.Name.ValueString(), data.Version.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom app, got error: %s", err))
+		return
+	}
+}
+
+func (r *CustomAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "name", "version")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, versionStr, ok := strings.Cut(req.ID, "/")
+	version, err := strconv.ParseInt(versionStr, 10, 64)
+	if !ok || name == "" || err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <name>/<version>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), version)...)
+}
+
+// customAppID builds the identifier of a custom app version
+func customAppID(name string, version int64) string {
+	return fmt.Sprintf("%s/%d", name, version)
+}
+
+// uploadIcon uploads the icon given as a file path or base64 content and
+// returns the hash of the uploaded content
+func (r *CustomAppResource) uploadIcon(ctx context.Context, name string, version int64, icon string) (string, error) {
+	content, err := loadCustomAppIcon(icon)
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.client.UploadCustomAppIcon(ctx, name, version, content); err != nil {
+		return "", err
+	}
+
+	return customAppIconHash(content), nil
+}
+
+// loadCustomAppIcon returns the PNG content of an icon given either as a path
+// to a file or as base64-encoded content
+func loadCustomAppIcon(icon string) ([]byte, error) {
+	var content []byte
+	if info, err := os.Stat(icon); err == nil && info.Mode().IsRegular() {
+		content, err = os.ReadFile(icon)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read icon file %s: %w", icon, err)
+		}
+	} else {
+		content, _ = base64.StdEncoding.DecodeString(icon)
+	}
+
+	if http.DetectContentType(content) != "image/png" {
+		return nil, fmt.Errorf("icon must be a PNG image given as a path to an existing file or as base64-encoded content")
+	}
+
+	return content, nil
+}
+
+// customAppIconHash returns the hex-encoded SHA-256 hash of icon content
+func customAppIconHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
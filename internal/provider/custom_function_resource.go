@@ -0,0 +1,408 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomFunctionResource{}
+var _ resource.ResourceWithImportState = &CustomFunctionResource{}
+var _ resource.ResourceWithConfigValidators = &CustomFunctionResource{}
+var _ resource.ResourceWithModifyPlan = &CustomFunctionResource{}
+var _ resource.ResourceWithUpgradeState = &CustomFunctionResource{}
+var _ resource.ResourceWithIdentity = &CustomFunctionResource{}
+
+// customFunctionNamePattern matches valid JavaScript function names
+var customFunctionNamePattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+func NewCustomFunctionResource() resource.Resource {
+	return &CustomFunctionResource{}
+}
+
+// CustomFunctionResource defines the resource implementation.
+type CustomFunctionResource struct {
+	client MakeAPI
+}
+
+// CustomFunctionResourceModel describes the resource data model.
+type CustomFunctionResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	TeamId      types.String `tfsdk:"team_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Code        types.String `tfsdk:"code"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *CustomFunctionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_function"
+}
+
+func (r *CustomFunctionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Make.com custom IML function resource, managing a JavaScript helper function that " +
+			"scenarios of a team can call in their mappings",
+
+		Version: 0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Custom function identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team the function belongs to. Defaults to the provider `default_team_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the function, matching the name of the function declared in `code`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(customFunctionNamePattern, "must be a valid JavaScript function name"),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the function",
+				Optional:            true,
+			},
+			"code": schema.StringAttribute{
+				MarkdownDescription: "JavaScript source of the function, e.g. `function toCents(amount) { return Math.round(amount * 100); }`",
+				Required:            true,
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *CustomFunctionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *CustomFunctionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Custom function identifier",
+				RequiredForImport: true,
+			},
+			"team_id": identityschema.StringAttribute{
+				Description:       "Team ID where the custom function belongs",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+func (r *CustomFunctionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(path.Root("team_id")),
+	}
+}
+
+func (r *CustomFunctionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.PlanDefaultIDs(ctx, req, resp, defaultID{Path: path.Root("team_id"), Required: true})
+
+	r.client.CheckReferences(ctx, resp.Plan, &resp.Diagnostics,
+		reference{Path: path.Root("team_id"), Endpoint: "v2/teams/%s"},
+	)
+}
+
+func (r *CustomFunctionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		r.client = unconfiguredClient
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomFunctionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_custom_function", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data CustomFunctionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Prepare the API request
+	apiReq := customFunctionRequest(data)
+
+	// Create the custom function via API
+	ctx, etags := makeapi.WithETagRecorder(ctx)
+	function, err := r.client.CreateCustomFunction(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom function, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag()})...)
+
+	// Map response to Terraform state. The code is kept from the plan as
+	// Make.com may reformat it.
+	data.Id = types.StringValue(function.ID.String())
+	data.Name = types.StringValue(function.Name)
+
+	if function.Description != "" {
+		data.Description = types.StringValue(function.Description)
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a custom function resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomFunctionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_custom_function", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data CustomFunctionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the custom function from the API
+	ctx, etags := makeapi.WithETagRecorder(ctx)
+	function, err := r.client.GetCustomFunction(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom function, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag()})...)
+
+	// Map API response to Terraform state
+	data.Id = types.StringValue(function.ID.String())
+	data.Name = types.StringValue(function.Name)
+
+	if function.Description != "" {
+		data.Description = types.StringValue(function.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	if function.TeamID != "" {
+		data.TeamId = types.StringValue(function.TeamID.String())
+	}
+
+	// Only whitespace differences around the code are ignored
+	if customFunctionCode(data.Code.ValueString()) != customFunctionCode(function.Code) {
+		data.Code = types.StringValue(function.Code)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomFunctionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer recoverPanic(ctx, "make_custom_function", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data, prior CustomFunctionResourceModel
+
+	// Read Terraform plan data and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Prepare the API request, which only sends the changed fields
+	apiReq := customFunctionRequest(data)
+	priorReq := customFunctionRequest(prior)
+
+	// Refuse to overwrite changes made since Terraform last read the custom function
+	version, diags := getObjectVersion(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the custom function via API
+	ctx = makeapi.WithIfMatch(ctx, version.ETag)
+	ctx, etags := makeapi.WithETagRecorder(ctx)
+	function, err := r.client.UpdateCustomFunction(ctx, data.Id.ValueString(), priorReq, apiReq)
+	if err != nil {
+		var conflict *makeapi.ConflictError
+		if errors.As(err, &conflict) {
+			addObjectChangedError(&resp.Diagnostics, "custom function", data.Id.ValueString())
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom function, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag()})...)
+
+	// Map response to Terraform state
+	if function.Description != "" {
+		data.Description = types.StringValue(function.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomFunctionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_custom_function", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data CustomFunctionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the custom function via API
+	err := r.client.DeleteCustomFunction(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom function, got error: %s", err))
+		return
+	}
+}
+
+func (r *CustomFunctionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "team_id", "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID may be prefixed by the team ID, e.g. <team_id>/<id>
+	importScopedID(ctx, req.ID, path.Root("team_id"), resp)
+}
+
+// customFunctionCode normalizes function code for comparison, ignoring
+// leading and trailing whitespace and line ending differences
+func customFunctionCode(code string) string {
+	return strings.TrimSpace(strings.ReplaceAll(code, "\r\n", "\n"))
+}
+
+// customFunctionRequest returns the API request for a custom function model
+func customFunctionRequest(data CustomFunctionResourceModel) makeapi.CustomFunctionRequest {
+	apiReq := makeapi.CustomFunctionRequest{
+		Name:   data.Name.ValueString(),
+		Code:   data.Code.ValueString(),
+		TeamID: data.TeamId.ValueString(),
+	}
+
+	if !data.Description.IsNull() {
+		apiReq.Description = data.Description.ValueString()
+	}
+
+	return apiReq
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CustomVariablesDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &CustomVariablesDataSource{}
+
+func NewCustomVariablesDataSource() datasource.DataSource {
+	return &CustomVariablesDataSource{}
+}
+
+// CustomVariablesDataSource defines the data source implementation.
+type CustomVariablesDataSource struct {
+	client MakeAPI
+}
+
+// CustomVariablesDataSourceModel describes the data source data model.
+type CustomVariablesDataSourceModel struct {
+	TeamId         types.String `tfsdk:"team_id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Variables      types.List   `tfsdk:"variables"`
+	Values         types.Map    `tfsdk:"values"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// customVariableAttrTypes are the attribute types of a variable in the variables list
+var customVariableAttrTypes = map[string]attr.Type{
+	"name":      types.StringType,
+	"type":      types.StringType,
+	"value":     types.StringType,
+	"is_secret": types.BoolType,
+}
+
+func (d *CustomVariablesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_variables"
+}
+
+func (d *CustomVariablesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom variables of a Make.com team or organization, e.g. to template scenario blueprints " +
+			"against the variables that exist. Exactly one of `team_id` or `organization_id` must be set.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team whose variables are listed",
+				Optional:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization whose variables are listed",
+				Optional:            true,
+			},
+			"variables": schema.ListNestedAttribute{
+				MarkdownDescription: "Custom variables, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the variable",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the variable: `string`, `number`, `boolean` or `date`",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value of the variable in its string form. Always null for secret variables.",
+							Computed:            true,
+						},
+						"is_secret": schema.BoolAttribute{
+							MarkdownDescription: "Whether the variable is secret",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"values": schema.MapAttribute{
+				MarkdownDescription: "Values of the non-secret variables keyed by variable name",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *CustomVariablesDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("team_id"),
+			path.MatchRoot("organization_id"),
+		),
+	}
+}
+
+func (d *CustomVariablesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomVariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_custom_variables", "read", nil, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data CustomVariablesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	var variables []makeapi.CustomVariableResponse
+	var err error
+	if !data.TeamId.IsNull() {
+		variables, err = d.client.ListTeamVariables(ctx, data.TeamId.ValueString())
+	} else {
+		variables, err = d.client.ListOrganizationVariables(ctx, data.OrganizationId.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list custom variables, got error: %s", err))
+		return
+	}
+
+	sort.Slice(variables, func(i, j int) bool {
+		return variables[i].Name < variables[j].Name
+	})
+
+	// Map response to Terraform state
+	variableValues := make([]attr.Value, 0, len(variables))
+	values := make(map[string]attr.Value, len(variables))
+	for _, variable := range variables {
+		value := types.StringNull()
+		if !variable.IsSecret && variable.Value != nil {
+			value = types.StringValue(valueToString(variable.Value))
+			values[variable.Name] = value
+		}
+
+		variableValues = append(variableValues, types.ObjectValueMust(customVariableAttrTypes, map[string]attr.Value{
+			"name":      types.StringValue(variable.Name),
+			"type":      types.StringValue(variable.Type),
+			"value":     value,
+			"is_secret": types.BoolValue(variable.IsSecret),
+		}))
+	}
+
+	data.Variables = types.ListValueMust(types.ObjectType{AttrTypes: customVariableAttrTypes}, variableValues)
+	data.Values = types.MapValueMust(types.StringType, values)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a custom variables data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
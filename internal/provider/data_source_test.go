@@ -71,6 +71,36 @@ data "make_connection" "test" {
 `
 }
 
+func TestAccWebhookDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.make_webhook.test", "name", "Test Webhook"),
+					resource.TestCheckResourceAttr("data.make_webhook.test", "active", "true"),
+					resource.TestCheckResourceAttrPair("data.make_webhook.test", "url", "make_webhook.test", "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhookDataSourceConfig() string {
+	return `
+resource "make_webhook" "test" {
+  name   = "Test Webhook"
+  active = true
+}
+
+data "make_webhook" "test" {
+  id = make_webhook.test.id
+}
+`
+}
+
 func TestAccTeamDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -126,26 +156,30 @@ data "make_organization" "test" {
 }
 
 func TestAccDataStoreDataSource(t *testing.T) {
+	dataStructureID := testAccDataStructureID(t)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDataStoreDataSourceConfig(),
+				Config: testAccDataStoreDataSourceConfig(dataStructureID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.make_data_store.test", "name", "Test Data Store"),
 					resource.TestCheckResourceAttr("data.make_data_store.test", "description", "Test data store description"),
+					resource.TestCheckResourceAttr("data.make_data_store.test", "data_structure_id", dataStructureID),
 				),
 			},
 		},
 	})
 }
 
-func testAccDataStoreDataSourceConfig() string {
+func testAccDataStoreDataSourceConfig(dataStructureID string) string {
 	return `
 resource "make_data_store" "test" {
-  name        = "Test Data Store"
-  description = "Test data store description"
+  name              = "Test Data Store"
+  description       = "Test data store description"
+  data_structure_id = "` + dataStructureID + `"
 }
 
 data "make_data_store" "test" {
@@ -153,3 +187,511 @@ data "make_data_store" "test" {
 }
 `
 }
+
+func TestAccProviderInfoDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderInfoDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.make_provider_info.test", "zone"),
+					resource.TestCheckResourceAttrSet("data.make_provider_info.test", "base_url"),
+					resource.TestCheckResourceAttrSet("data.make_provider_info.test", "user_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProviderInfoDataSourceConfig() string {
+	return `
+data "make_provider_info" "test" {}
+`
+}
+
+func TestAccUserRolesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserRolesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.make_user_roles.test", "roles.0.id"),
+					resource.TestCheckResourceAttr("data.make_user_roles.test", "roles.0.category", "team"),
+					resource.TestCheckResourceAttrSet("data.make_user_roles.test", "ids.team_admin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserRolesDataSourceConfig() string {
+	return `
+data "make_user_roles" "test" {
+  category = "team"
+}
+`
+}
+
+func TestAccCustomVariablesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomVariablesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.make_custom_variables.test", "variables.#", "2"),
+					resource.TestCheckResourceAttr("data.make_custom_variables.test", "variables.0.name", "tf_acc_region"),
+					resource.TestCheckResourceAttr("data.make_custom_variables.test", "variables.1.name", "tf_acc_secret"),
+					resource.TestCheckNoResourceAttr("data.make_custom_variables.test", "variables.1.value"),
+					resource.TestCheckResourceAttr("data.make_custom_variables.test", "values.%", "1"),
+					resource.TestCheckResourceAttr("data.make_custom_variables.test", "values.tf_acc_region", "eu"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomVariablesDataSourceConfig() string {
+	return `
+resource "make_team" "test" {
+  name = "Test Team Custom Variables"
+}
+
+resource "make_team_variable" "region" {
+  team_id = make_team.test.id
+  name    = "tf_acc_region"
+  type    = "string"
+  value   = "eu"
+}
+
+resource "make_team_variable" "secret" {
+  team_id   = make_team.test.id
+  name      = "tf_acc_secret"
+  type      = "string"
+  value     = "hunter2"
+  is_secret = true
+}
+
+data "make_custom_variables" "test" {
+  team_id = make_team.test.id
+
+  depends_on = [make_team_variable.region, make_team_variable.secret]
+}
+`
+}
+
+func TestAccDataStructuresDataSource(t *testing.T) {
+	teamID := testAccEnv(t, "MAKE_TEST_TEAM_ID")
+	if teamID == "" {
+		t.Skip("MAKE_TEST_TEAM_ID must be set to a team with data structures for data structures acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataStructuresDataSourceConfig(teamID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.make_data_structures.test", "data_structures.0.id"),
+					resource.TestCheckResourceAttrSet("data.make_data_structures.test", "data_structures.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataStructuresDataSourceConfig(teamID string) string {
+	return `
+data "make_data_structures" "test" {
+  team_id = "` + teamID + `"
+}
+`
+}
+
+func TestAccDataStoreRecordsDataSource(t *testing.T) {
+	dataStructureID := testAccDataStructureID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataStoreRecordsDataSourceConfig(dataStructureID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.make_data_store_records.filtered", "records.%", "2"),
+					resource.TestCheckResourceAttr("data.make_data_store_records.filtered", "records.bob", `{"name":"Bob"}`),
+					resource.TestCheckNoResourceAttr("data.make_data_store_records.filtered", "records.alice"),
+					resource.TestCheckResourceAttr("data.make_data_store_records.limited", "records.%", "1"),
+					resource.TestCheckResourceAttr("data.make_data_store_records.limited", "records.alice", `{"name":"Alice"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataStoreRecordsDataSourceConfig(dataStructureID string) string {
+	return `
+resource "make_data_store" "test" {
+  name              = "Test Data Store Records Lookup"
+  data_structure_id = "` + dataStructureID + `"
+}
+
+resource "make_data_store_records" "test" {
+  data_store_id = make_data_store.test.id
+
+  records = {
+    alice = jsonencode({ name = "Alice" })
+    bob   = jsonencode({ name = "Bob" })
+    carol = jsonencode({ name = "Carol" })
+  }
+}
+
+data "make_data_store_records" "filtered" {
+  data_store_id = make_data_store_records.test.data_store_id
+  keys          = ["bob", "carol", "dave"]
+}
+
+data "make_data_store_records" "limited" {
+  data_store_id = make_data_store_records.test.data_store_id
+  limit         = 1
+}
+`
+}
+
+func TestAccAuditLogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditLogsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.make_audit_logs.test", "entries.#", "1"),
+					resource.TestCheckResourceAttr("data.make_audit_logs.test", "entries.0.entity_type", "team"),
+					resource.TestCheckResourceAttrSet("data.make_audit_logs.test", "entries.0.action"),
+					resource.TestCheckResourceAttrSet("data.make_audit_logs.test", "entries.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuditLogsDataSourceConfig() string {
+	return `
+resource "make_organization" "test" {
+  name = "Test Organization Audit Logs"
+}
+
+resource "make_team" "test" {
+  name            = "Test Team Audit Logs"
+  organization_id = make_organization.test.id
+}
+
+data "make_audit_logs" "test" {
+  organization_id = make_organization.test.id
+  entity_type     = "team"
+  entity_id       = make_team.test.id
+  limit           = 1
+}
+`
+}
+
+func TestAccOrganizationSubscriptionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationSubscriptionDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.make_organization_subscription.test", "plan"),
+					resource.TestCheckResourceAttrSet("data.make_organization_subscription.test", "operations_limit"),
+					resource.TestCheckResourceAttrSet("data.make_organization_subscription.test", "operations_remaining"),
+					resource.TestCheckResourceAttrSet("data.make_organization_subscription.test", "period_end"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationSubscriptionDataSourceConfig() string {
+	return `
+resource "make_organization" "test" {
+  name = "Test Organization Subscription"
+}
+
+data "make_organization_subscription" "test" {
+  organization_id = make_organization.test.id
+}
+`
+}
+
+func TestAccTeamUsageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamUsageDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.make_team_usage.test", "operations", "0"),
+					resource.TestCheckResourceAttr("data.make_team_usage.test", "data_transfer_mb", "0"),
+					resource.TestCheckResourceAttrSet("data.make_team_usage.test", "days.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamUsageDataSourceConfig() string {
+	return `
+resource "make_team" "test" {
+  name = "Test Team Usage"
+}
+
+data "make_team_usage" "test" {
+  team_id = make_team.test.id
+  from    = timeadd(plantimestamp(), "-168h")
+  to      = plantimestamp()
+}
+`
+}
+
+func TestAccOrganizationAnalyticsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAnalyticsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.make_organization_analytics.test", "operations", "0"),
+					resource.TestCheckResourceAttr("data.make_organization_analytics.test", "scenarios.#", "0"),
+					resource.TestCheckResourceAttrSet("data.make_organization_analytics.test", "days.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationAnalyticsDataSourceConfig() string {
+	return `
+resource "make_organization" "test" {
+  name = "Test Organization Analytics"
+}
+
+data "make_organization_analytics" "test" {
+  organization_id = make_organization.test.id
+  scenario_limit  = 5
+}
+`
+}
+
+func TestAccAppsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.make_apps.test", "apps.#"),
+					resource.TestCheckResourceAttr("data.make_apps.test", "include_beta", "false"),
+					resource.TestCheckTypeSetElemAttr("data.make_apps.test", "names.*", "http"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppsDataSourceConfig() string {
+	return `
+data "make_apps" "test" {
+  include_beta = false
+}
+`
+}
+
+func TestAccAppModulesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppModulesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.make_app_modules.test", "modules.#"),
+					resource.TestCheckResourceAttr("data.make_app_modules.test", "modules.0.type", "action"),
+					resource.TestCheckResourceAttrSet("data.make_app_modules.test", "modules.0.parameters"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppModulesDataSourceConfig() string {
+	return `
+data "make_app_modules" "test" {
+  app_name    = "http"
+  app_version = 3
+  type        = "action"
+}
+`
+}
+
+func TestAccWebhookLogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookLogsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.make_webhook_logs.test", "logs.#", "0"),
+					resource.TestCheckResourceAttrPair("data.make_webhook_logs.test", "webhook_id", "make_webhook.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhookLogsDataSourceConfig() string {
+	return `
+resource "make_webhook" "test" {
+  name   = "Test Webhook Logs"
+  active = true
+}
+
+data "make_webhook_logs" "test" {
+  webhook_id = make_webhook.test.id
+  limit      = 10
+}
+`
+}
+
+func TestAccTeamsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.make_teams.test", "teams.#", "2"),
+					resource.TestCheckResourceAttr("data.make_teams.test", "teams.0.name", "Test Teams A"),
+					resource.TestCheckResourceAttrPair("data.make_teams.test", "ids.Test Teams B", "make_team.b", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamsDataSourceConfig() string {
+	return `
+resource "make_organization" "test" {
+  name = "Test Organization Teams"
+}
+
+resource "make_team" "a" {
+  name            = "Test Teams A"
+  organization_id = make_organization.test.id
+}
+
+resource "make_team" "b" {
+  name            = "Test Teams B"
+  organization_id = make_organization.test.id
+}
+
+data "make_teams" "test" {
+  organization_id = make_organization.test.id
+
+  depends_on = [make_team.a, make_team.b]
+}
+`
+}
+
+func TestAccOrganizationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.make_organizations.test", "organizations.#"),
+					resource.TestCheckResourceAttrPair("data.make_organizations.test", "ids.Test Organizations", "make_organization.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationsDataSourceConfig() string {
+	return `
+resource "make_organization" "test" {
+  name = "Test Organizations"
+}
+
+data "make_organizations" "test" {
+  depends_on = [make_organization.test]
+}
+`
+}
+
+func TestAccImportBlocksDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImportBlocksDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.make_import_blocks.test", "resources.#", "2"),
+					resource.TestCheckResourceAttr("data.make_import_blocks.test", "resources.0.address", "make_connection.test_import_blocks_gmail"),
+					resource.TestCheckResourceAttr("data.make_import_blocks.test", "resources.1.address", "make_scenario.test_import_blocks_scenario"),
+					resource.TestCheckResourceAttrPair("data.make_import_blocks.test", "resources.1.id", "make_scenario.adopted", "id"),
+					resource.TestCheckResourceAttrSet("data.make_import_blocks.test", "import_blocks"),
+					resource.TestCheckResourceAttrSet("data.make_import_blocks.test", "config"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImportBlocksDataSourceConfig() string {
+	return `
+resource "make_team" "test" {
+  name = "Test Import Blocks"
+}
+
+resource "make_scenario" "adopted" {
+  name    = "Test Import Blocks Scenario"
+  team_id = make_team.test.id
+}
+
+resource "make_scenario" "managed" {
+  name    = "Test Import Blocks Managed"
+  team_id = make_team.test.id
+}
+
+resource "make_connection" "adopted" {
+  name     = "Test Import Blocks Gmail"
+  app_name = "gmail"
+  team_id  = make_team.test.id
+}
+
+data "make_import_blocks" "test" {
+  team_id        = make_team.test.id
+  resource_types = ["make_connection", "make_scenario"]
+  exclude_ids    = [make_scenario.managed.id]
+
+  depends_on = [make_scenario.adopted, make_connection.adopted]
+}
+`
+}
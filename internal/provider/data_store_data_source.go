@@ -19,7 +19,7 @@ func NewDataStoreDataSource() datasource.DataSource {
 
 // DataStoreDataSource defines the data source implementation.
 type DataStoreDataSource struct {
-	client *MakeAPIClient
+	client MakeAPI
 }
 
 // DataStoreDataSourceModel describes the data source data model.
@@ -28,6 +28,14 @@ type DataStoreDataSourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	TeamId      types.String `tfsdk:"team_id"`
+
+	DataStructureId types.String `tfsdk:"data_structure_id"`
+	MaxSizeMB       types.Int64  `tfsdk:"max_size_mb"`
+	Strict          types.Bool   `tfsdk:"strict"`
+	RecordCount     types.Int64  `tfsdk:"record_count"`
+	Size            types.Int64  `tfsdk:"size"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
 }
 
 func (d *DataStoreDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -55,6 +63,27 @@ func (d *DataStoreDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				MarkdownDescription: "Team ID where the data store belongs",
 				Computed:            true,
 			},
+			"data_structure_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the data structure defining the records of the data store",
+				Computed:            true,
+			},
+			"max_size_mb": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size of the data store in megabytes",
+				Computed:            true,
+			},
+			"strict": schema.BoolAttribute{
+				MarkdownDescription: "Whether records not matching the data structure are rejected",
+				Computed:            true,
+			},
+			"record_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of records in the data store",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the data stored in the data store in bytes",
+				Computed:            true,
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
 		},
 	}
 }
@@ -64,11 +93,11 @@ func (d *DataStoreDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*MakeAPIClient)
+	client, ok := req.ProviderData.(MakeAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *MakeAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -77,6 +106,9 @@ func (d *DataStoreDataSource) Configure(ctx context.Context, req datasource.Conf
 }
 
 func (d *DataStoreDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_data_store", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
 	var data DataStoreDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -84,13 +116,15 @@ func (d *DataStoreDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
 	ds, err := d.client.GetDataStore(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data store, got error: %s", err))
 		return
 	}
 
-	data.Id = types.StringValue(ds.ID)
+	data.Id = types.StringValue(ds.ID.String())
 	data.Name = types.StringValue(ds.Name)
 	if ds.Description == "" {
 		data.Description = types.StringNull()
@@ -100,8 +134,17 @@ func (d *DataStoreDataSource) Read(ctx context.Context, req datasource.ReadReque
 	if ds.TeamID == "" {
 		data.TeamId = types.StringNull()
 	} else {
-		data.TeamId = types.StringValue(ds.TeamID)
+		data.TeamId = types.StringValue(ds.TeamID.String())
+	}
+	if ds.DataStructureID == "" {
+		data.DataStructureId = types.StringNull()
+	} else {
+		data.DataStructureId = types.StringValue(ds.DataStructureID.String())
 	}
+	data.MaxSizeMB = types.Int64Value(ds.MaxSizeMB)
+	data.Strict = types.BoolValue(ds.Strict)
+	data.RecordCount = types.Int64Value(ds.Records)
+	data.Size = types.Int64Value(ds.Size)
 
 	tflog.Trace(ctx, "read a data store data source")
 
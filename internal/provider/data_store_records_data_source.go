@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DataStoreRecordsDataSource{}
+
+func NewDataStoreRecordsDataSource() datasource.DataSource {
+	return &DataStoreRecordsDataSource{}
+}
+
+// DataStoreRecordsDataSource defines the data source implementation.
+type DataStoreRecordsDataSource struct {
+	client MakeAPI
+}
+
+// DataStoreRecordsDataSourceModel describes the data source data model.
+type DataStoreRecordsDataSourceModel struct {
+	DataStoreId types.String `tfsdk:"data_store_id"`
+	Keys        types.List   `tfsdk:"keys"`
+	Limit       types.Int64  `tfsdk:"limit"`
+	Records     types.Map    `tfsdk:"records"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+func (d *DataStoreRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_store_records"
+}
+
+func (d *DataStoreRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Records of a Make.com data store, so that lookup data managed in Make.com can be " +
+			"consumed by other resources or outputs",
+
+		Attributes: map[string]schema.Attribute{
+			"data_store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the data store whose records are read",
+				Required:            true,
+			},
+			"keys": schema.ListAttribute{
+				MarkdownDescription: "Only return the records with these keys. Keys without a record are ignored.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of records to return, taking records in key order",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"records": schema.MapAttribute{
+				MarkdownDescription: "JSON-encoded record data keyed by record key, to be decoded with `jsondecode`",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *DataStoreRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DataStoreRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_data_store_records", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data DataStoreRecordsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	var keys map[string]bool
+	if !data.Keys.IsNull() {
+		var keyList []string
+		resp.Diagnostics.Append(data.Keys.ElementsAs(ctx, &keyList, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		keys = make(map[string]bool, len(keyList))
+		for _, key := range keyList {
+			keys[key] = true
+		}
+	}
+
+	records, err := d.client.ListDataStoreRecords(ctx, data.DataStoreId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data store records, got error: %s", err))
+		return
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Key < records[j].Key
+	})
+
+	// Map response to Terraform state
+	values := make(map[string]attr.Value, len(records))
+	for _, record := range records {
+		if keys != nil && !keys[record.Key] {
+			continue
+		}
+
+		if !data.Limit.IsNull() && int64(len(values)) >= data.Limit.ValueInt64() {
+			break
+		}
+
+		values[record.Key] = types.StringValue(string(record.Data))
+	}
+
+	data.Records = types.MapValueMust(types.StringType, values)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a data store records data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,429 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DataStoreRecordsResource{}
+var _ resource.ResourceWithImportState = &DataStoreRecordsResource{}
+var _ resource.ResourceWithConfigValidators = &DataStoreRecordsResource{}
+var _ resource.ResourceWithModifyPlan = &DataStoreRecordsResource{}
+var _ resource.ResourceWithUpgradeState = &DataStoreRecordsResource{}
+var _ resource.ResourceWithIdentity = &DataStoreRecordsResource{}
+
+func NewDataStoreRecordsResource() resource.Resource {
+	return &DataStoreRecordsResource{}
+}
+
+// DataStoreRecordsResource defines the resource implementation.
+type DataStoreRecordsResource struct {
+	client MakeAPI
+}
+
+// DataStoreRecordsResourceModel describes the resource data model.
+type DataStoreRecordsResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	DataStoreId types.String `tfsdk:"data_store_id"`
+	Records     types.Map    `tfsdk:"records"`
+	IgnoreDrift types.Bool   `tfsdk:"ignore_drift"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DataStoreRecordsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_store_records"
+}
+
+func (r *DataStoreRecordsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Seeds records into a Make.com data store using the batch endpoints. Only the records " +
+			"declared here are managed; other records of the data store are left untouched. Destroying the " +
+			"resource deletes the managed records.",
+
+		Version: 0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the record set, equal to the data store ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the data store the records are written to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"records": schema.MapAttribute{
+				MarkdownDescription: "Records keyed by record key. Each value is the JSON-encoded record data, " +
+					"e.g. `jsonencode({ name = \"Alice\" })`.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(jsonObject()),
+				},
+			},
+			"ignore_drift": schema.BoolAttribute{
+				MarkdownDescription: "Whether changes made to the records in Make.com are ignored, e.g. when " +
+					"scenarios update the seeded data. Changes to the configuration are still applied. " +
+					"Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *DataStoreRecordsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *DataStoreRecordsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"data_store_id": identityschema.StringAttribute{
+				Description:       "ID of the data store the records are written to",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *DataStoreRecordsResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(path.Root("data_store_id")),
+	}
+}
+
+func (r *DataStoreRecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.CheckReferences(ctx, req.Plan, &resp.Diagnostics,
+		reference{Path: path.Root("data_store_id"), Endpoint: "v2/data-stores/%s"},
+	)
+}
+
+func (r *DataStoreRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		r.client = unconfiguredClient
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DataStoreRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_data_store_records", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data DataStoreRecordsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var records map[string]string
+	resp.Diagnostics.Append(data.Records.ElementsAs(ctx, &records, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Insert the records via API, failing on keys that already exist
+	err := r.client.WriteDataStoreRecords(ctx, data.DataStoreId.ValueString(), dataStoreRecords(records, nil), false)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create data store records, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.Id = data.DataStoreId
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a data store records resource", map[string]interface{}{
+		"count": len(records),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataStoreRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_data_store_records", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data DataStoreRecordsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Records changed in Make.com are deliberately not refreshed
+	imported := data.Records.IsNull()
+	if data.IgnoreDrift.ValueBool() && !imported {
+		return
+	}
+
+	// Get the records from the API
+	remote, err := r.client.ListDataStoreRecords(ctx, data.DataStoreId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data store records, got error: %s", err))
+		return
+	}
+
+	remoteRecords := make(map[string]string, len(remote))
+	for _, record := range remote {
+		remoteRecords[record.Key] = string(record.Data)
+	}
+
+	// Map API response to Terraform state. Imported record sets adopt every
+	// record of the data store, others only refresh the records they manage.
+	records := make(map[string]attr.Value, len(remoteRecords))
+	if imported {
+		for key, value := range remoteRecords {
+			records[key] = types.StringValue(value)
+		}
+	} else {
+		var prior map[string]string
+		resp.Diagnostics.Append(data.Records.ElementsAs(ctx, &prior, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for key, value := range prior {
+			remoteValue, ok := remoteRecords[key]
+			if !ok {
+				continue
+			}
+
+			if jsonEqual(value, remoteValue) {
+				records[key] = types.StringValue(value)
+			} else {
+				records[key] = types.StringValue(remoteValue)
+			}
+		}
+	}
+
+	data.Id = data.DataStoreId
+	data.Records = types.MapValueMust(types.StringType, records)
+
+	if data.IgnoreDrift.IsNull() {
+		data.IgnoreDrift = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataStoreRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer recoverPanic(ctx, "make_data_store_records", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data, state DataStoreRecordsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var records, prior map[string]string
+	resp.Diagnostics.Append(data.Records.ElementsAs(ctx, &records, false)...)
+	resp.Diagnostics.Append(state.Records.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the records that are no longer declared
+	var removed []string
+	for key := range prior {
+		if _, ok := records[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+
+	if len(removed) > 0 {
+		err := r.client.DeleteDataStoreRecords(ctx, data.DataStoreId.ValueString(), removed)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete data store records, got error: %s", err))
+			return
+		}
+	}
+
+	// Write the records that were added or changed
+	changed := dataStoreRecords(records, prior)
+	if len(changed) > 0 {
+		err := r.client.WriteDataStoreRecords(ctx, data.DataStoreId.ValueString(), changed, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update data store records, got error: %s", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataStoreRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_data_store_records", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data DataStoreRecordsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	var records map[string]string
+	resp.Diagnostics.Append(data.Records.ElementsAs(ctx, &records, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys := make([]string, 0, len(records))
+	for key := range records {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// Delete the managed records via API
+	err := r.client.DeleteDataStoreRecords(ctx, data.DataStoreId.ValueString(), keys)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete data store records, got error: %s", err))
+		return
+	}
+}
+
+func (r *DataStoreRecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "data_store_id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data_store_id"), req.ID)...)
+}
+
+// dataStoreRecords converts records keyed by record key into API records,
+// sorted by key. Records whose data is unchanged from prior are skipped.
+func dataStoreRecords(records, prior map[string]string) []makeapi.DataStoreRecord {
+	keys := make([]string, 0, len(records))
+	for key, value := range records {
+		if priorValue, ok := prior[key]; ok && jsonEqual(value, priorValue) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make([]makeapi.DataStoreRecord, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, makeapi.DataStoreRecord{
+			Key:  key,
+			Data: json.RawMessage(records[key]),
+		})
+	}
+
+	return result
+}
@@ -2,20 +2,33 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DataStoreResource{}
 var _ resource.ResourceWithImportState = &DataStoreResource{}
+var _ resource.ResourceWithConfigValidators = &DataStoreResource{}
+var _ resource.ResourceWithModifyPlan = &DataStoreResource{}
+var _ resource.ResourceWithUpgradeState = &DataStoreResource{}
+var _ resource.ResourceWithIdentity = &DataStoreResource{}
 
 func NewDataStoreResource() resource.Resource {
 	return &DataStoreResource{}
@@ -23,7 +36,7 @@ func NewDataStoreResource() resource.Resource {
 
 // DataStoreResource defines the resource implementation.
 type DataStoreResource struct {
-	client *MakeAPIClient
+	client MakeAPI
 }
 
 // DataStoreResourceModel describes the resource data model.
@@ -32,6 +45,15 @@ type DataStoreResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	TeamId      types.String `tfsdk:"team_id"`
+
+	DataStructureId types.String `tfsdk:"data_structure_id"`
+	MaxSizeMB       types.Int64  `tfsdk:"max_size_mb"`
+	Strict          types.Bool   `tfsdk:"strict"`
+	RecordCount     types.Int64  `tfsdk:"record_count"`
+	Size            types.Int64  `tfsdk:"size"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *DataStoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,6 +64,8 @@ func (r *DataStoreResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Make.com data store resource",
 
+		Version: 0,
+
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -53,31 +77,118 @@ func (r *DataStoreResource) Schema(ctx context.Context, req resource.SchemaReque
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the data store",
 				Required:            true,
+				Validators:          nameValidators(),
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the data store",
 				Optional:            true,
 			},
 			"team_id": schema.StringAttribute{
-				MarkdownDescription: "Team ID where the data store belongs",
+				MarkdownDescription: "Team ID where the data store belongs. Defaults to the provider `default_team_id`. " +
+					"Changing the team forces a new data store to be created.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					requiresReplaceOwner("Make cannot move a data store to another team, so changing the team replaces it."),
+				},
+			},
+			"data_structure_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the data structure defining the records of the data store",
+				Required:            true,
+			},
+			"max_size_mb": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size of the data store in megabytes. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"strict": schema.BoolAttribute{
+				MarkdownDescription: "Whether records not matching the data structure are rejected. Defaults to `false`.",
 				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"record_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of records in the data store",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the data stored in the data store in bytes",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *DataStoreResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *DataStoreResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Data store identifier",
+				RequiredForImport: true,
+			},
+			"team_id": identityschema.StringAttribute{
+				Description:       "Team ID where the data store belongs",
+				OptionalForImport: true,
 			},
 		},
 	}
 }
 
+func (r *DataStoreResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(
+			path.Root("team_id"),
+			path.Root("data_structure_id"),
+		),
+	}
+}
+
+func (r *DataStoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.PlanDefaultIDs(ctx, req, resp, defaultID{Path: path.Root("team_id")})
+
+	r.client.CheckReferences(ctx, resp.Plan, &resp.Diagnostics,
+		reference{Path: path.Root("team_id"), Endpoint: "v2/teams/%s"},
+	)
+}
+
 func (r *DataStoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
+		r.client = unconfiguredClient
 		return
 	}
 
-	client, ok := req.ProviderData.(*MakeAPIClient)
+	client, ok := req.ProviderData.(MakeAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *MakeAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -87,6 +198,10 @@ func (r *DataStoreResource) Configure(ctx context.Context, req resource.Configur
 }
 
 func (r *DataStoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_data_store", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DataStoreResourceModel
 
 	// Read Terraform plan data into the model
@@ -96,33 +211,41 @@ func (r *DataStoreResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	apiReq := DataStoreRequest{
-		Name: data.Name.ValueString(),
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Description.IsNull() {
-		apiReq.Description = data.Description.ValueString()
-	}
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
 
-	if !data.TeamId.IsNull() {
-		apiReq.TeamID = data.TeamId.ValueString()
-	}
+	apiReq := dataStoreRequest(data)
 
+	ctx, etags := makeapi.WithETagRecorder(ctx)
 	ds, err := r.client.CreateDataStore(ctx, apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create data store, got error: %s", err))
 		return
 	}
 
-	data.Id = types.StringValue(ds.ID)
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag()})...)
+
+	data.Id = types.StringValue(ds.ID.String())
 	data.Name = types.StringValue(ds.Name)
+	data.DataStructureId = types.StringValue(ds.DataStructureID.String())
+	data.MaxSizeMB = types.Int64Value(ds.MaxSizeMB)
+	data.Strict = types.BoolValue(ds.Strict)
+	data.RecordCount = types.Int64Value(ds.Records)
+	data.Size = types.Int64Value(ds.Size)
 
 	if ds.Description != "" {
 		data.Description = types.StringValue(ds.Description)
 	}
 
 	if ds.TeamID != "" {
-		data.TeamId = types.StringValue(ds.TeamID)
+		data.TeamId = types.StringValue(ds.TeamID.String())
 	}
 
 	tflog.Trace(ctx, "created a data store resource")
@@ -131,6 +254,10 @@ func (r *DataStoreResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *DataStoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_data_store", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DataStoreResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -139,14 +266,32 @@ func (r *DataStoreResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
+	ctx, etags := makeapi.WithETagRecorder(ctx)
 	ds, err := r.client.GetDataStore(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data store, got error: %s", err))
 		return
 	}
 
-	data.Id = types.StringValue(ds.ID)
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag()})...)
+
+	data.Id = types.StringValue(ds.ID.String())
 	data.Name = types.StringValue(ds.Name)
+	data.DataStructureId = types.StringValue(ds.DataStructureID.String())
+	data.MaxSizeMB = types.Int64Value(ds.MaxSizeMB)
+	data.Strict = types.BoolValue(ds.Strict)
+	data.RecordCount = types.Int64Value(ds.Records)
+	data.Size = types.Int64Value(ds.Size)
 
 	if ds.Description != "" {
 		data.Description = types.StringValue(ds.Description)
@@ -155,43 +300,71 @@ func (r *DataStoreResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if ds.TeamID != "" {
-		data.TeamId = types.StringValue(ds.TeamID)
-	} else {
-		data.TeamId = types.StringNull()
+		data.TeamId = types.StringValue(ds.TeamID.String())
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DataStoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data DataStoreResourceModel
+	defer recoverPanic(ctx, "make_data_store", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data, prior DataStoreResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	apiReq := DataStoreRequest{
-		Name: data.Name.ValueString(),
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Description.IsNull() {
-		apiReq.Description = data.Description.ValueString()
-	}
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
 
-	if !data.TeamId.IsNull() {
-		apiReq.TeamID = data.TeamId.ValueString()
+	// Prepare the API request, which only sends the changed fields
+	apiReq := dataStoreRequest(data)
+	priorReq := dataStoreRequest(prior)
+
+	// Refuse to overwrite changes made since Terraform last read the data store
+	version, diags := getObjectVersion(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	ds, err := r.client.UpdateDataStore(ctx, data.Id.ValueString(), apiReq)
+	ctx = makeapi.WithIfMatch(ctx, version.ETag)
+	ctx, etags := makeapi.WithETagRecorder(ctx)
+	ds, err := r.client.UpdateDataStore(ctx, data.Id.ValueString(), priorReq, apiReq)
 	if err != nil {
+		var conflict *makeapi.ConflictError
+		if errors.As(err, &conflict) {
+			addObjectChangedError(&resp.Diagnostics, "data store", data.Id.ValueString())
+			return
+		}
+
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update data store, got error: %s", err))
 		return
 	}
 
-	data.Id = types.StringValue(ds.ID)
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag()})...)
+
+	data.Id = types.StringValue(ds.ID.String())
 	data.Name = types.StringValue(ds.Name)
+	data.DataStructureId = types.StringValue(ds.DataStructureID.String())
+	data.MaxSizeMB = types.Int64Value(ds.MaxSizeMB)
+	data.Strict = types.BoolValue(ds.Strict)
+	data.RecordCount = types.Int64Value(ds.Records)
+	data.Size = types.Int64Value(ds.Size)
 
 	if ds.Description != "" {
 		data.Description = types.StringValue(ds.Description)
@@ -200,7 +373,7 @@ func (r *DataStoreResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	if ds.TeamID != "" {
-		data.TeamId = types.StringValue(ds.TeamID)
+		data.TeamId = types.StringValue(ds.TeamID.String())
 	} else {
 		data.TeamId = types.StringNull()
 	}
@@ -209,6 +382,9 @@ func (r *DataStoreResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *DataStoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_data_store", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
 	var data DataStoreResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -217,6 +393,16 @@ func (r *DataStoreResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.client.DeleteDataStore(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete data store, got error: %s", err))
@@ -225,5 +411,33 @@ func (r *DataStoreResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *DataStoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "team_id", "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID may be prefixed by the team ID, e.g. <team_id>/<id>
+	importScopedID(ctx, req.ID, path.Root("team_id"), resp)
+}
+
+// dataStoreRequest returns the API request for a data store model
+func dataStoreRequest(data DataStoreResourceModel) makeapi.DataStoreRequest {
+	apiReq := makeapi.DataStoreRequest{
+		Name:            data.Name.ValueString(),
+		DataStructureID: data.DataStructureId.ValueString(),
+		MaxSizeMB:       data.MaxSizeMB.ValueInt64(),
+		Strict:          data.Strict.ValueBool(),
+	}
+
+	if !data.Description.IsNull() {
+		apiReq.Description = data.Description.ValueString()
+	}
+
+	if !data.TeamId.IsNull() {
+		apiReq.TeamID = data.TeamId.ValueString()
+	}
+
+	return apiReq
 }
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DataStructuresDataSource{}
+
+func NewDataStructuresDataSource() datasource.DataSource {
+	return &DataStructuresDataSource{}
+}
+
+// DataStructuresDataSource defines the data source implementation.
+type DataStructuresDataSource struct {
+	client MakeAPI
+}
+
+// DataStructuresDataSourceModel describes the data source data model.
+type DataStructuresDataSourceModel struct {
+	TeamId         types.String `tfsdk:"team_id"`
+	Name           types.String `tfsdk:"name"`
+	DataStructures types.List   `tfsdk:"data_structures"`
+	Ids            types.Map    `tfsdk:"ids"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// dataStructureAttrTypes are the attribute types of a data structure in the data_structures list
+var dataStructureAttrTypes = map[string]attr.Type{
+	"id":     types.StringType,
+	"name":   types.StringType,
+	"strict": types.BoolType,
+	"spec":   jsontypes.NormalizedType{},
+}
+
+func (d *DataStructuresDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_structures"
+}
+
+func (d *DataStructuresDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data structures of a Make.com team, so that data stores and webhooks can reference " +
+			"existing structures by name",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team whose data structures are listed",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return data structures with this name",
+				Optional:            true,
+			},
+			"data_structures": schema.ListNestedAttribute{
+				MarkdownDescription: "Data structures, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Data structure identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the data structure",
+							Computed:            true,
+						},
+						"strict": schema.BoolAttribute{
+							MarkdownDescription: "Whether data not matching the structure is rejected",
+							Computed:            true,
+						},
+						"spec": schema.StringAttribute{
+							MarkdownDescription: "Field specification of the data structure as a JSON string",
+							CustomType:          jsontypes.NormalizedType{},
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				MarkdownDescription: "Data structure identifiers keyed by data structure name",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *DataStructuresDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DataStructuresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_data_structures", "read", nil, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data DataStructuresDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	structures, err := d.client.ListDataStructures(ctx, data.TeamId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list data structures, got error: %s", err))
+		return
+	}
+
+	sort.Slice(structures, func(i, j int) bool {
+		return structures[i].Name < structures[j].Name
+	})
+
+	// Map response to Terraform state
+	structureValues := make([]attr.Value, 0, len(structures))
+	ids := make(map[string]attr.Value, len(structures))
+	for _, structure := range structures {
+		if !data.Name.IsNull() && structure.Name != data.Name.ValueString() {
+			continue
+		}
+
+		spec := jsontypes.NewNormalizedNull()
+		if len(structure.Spec) > 0 {
+			spec = jsontypes.NewNormalizedValue(string(structure.Spec))
+		}
+
+		structureValues = append(structureValues, types.ObjectValueMust(dataStructureAttrTypes, map[string]attr.Value{
+			"id":     types.StringValue(structure.ID.String()),
+			"name":   types.StringValue(structure.Name),
+			"strict": types.BoolValue(structure.Strict),
+			"spec":   spec,
+		}))
+		ids[structure.Name] = types.StringValue(structure.ID.String())
+	}
+
+	data.DataStructures = types.ListValueMust(types.ObjectType{AttrTypes: dataStructureAttrTypes}, structureValues)
+	data.Ids = types.MapValueMust(types.StringType, ids)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a data structures data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
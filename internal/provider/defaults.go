@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// defaultID describes an attribute holding a team or organization ID that
+// falls back to the default_team_id or default_organization_id of the
+// provider when it is omitted.
+type defaultID struct {
+	// Path of the attribute holding the ID
+	Path path.Path
+
+	// Organization selects default_organization_id instead of default_team_id
+	Organization bool
+
+	// Required fails the plan when neither the configuration nor the provider
+	// gives an ID
+	Required bool
+}
+
+// PlanDefaultIDs fills omitted team and organization IDs of a resource being
+// created with the provider defaults. Existing resources keep the ID they
+// were created with, so changing a default never moves or replaces them.
+func (c *apiClient) PlanDefaultIDs(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, ids ...defaultID) {
+	// The attributes are kept from state by UseStateForUnknown
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var profile types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("auth_profile"), &profile)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown profiles are reported by the API requests using them. The client
+	// is nil while the provider configuration is not known yet, in which case
+	// required IDs are left for the API to check.
+	var client *makeapi.Client
+	if c != nil {
+		var err error
+		if client, err = c.ProfileClient(withAuthProfile(ctx, profile)); err != nil {
+			return
+		}
+	}
+
+	for _, id := range ids {
+		var value types.String
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, id.Path, &value)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !value.IsNull() {
+			continue
+		}
+
+		attribute, defaultValue := "default_team_id", ""
+		if id.Organization {
+			attribute = "default_organization_id"
+		}
+
+		if client != nil {
+			defaultValue = client.DefaultTeamID
+			if id.Organization {
+				defaultValue = client.DefaultOrganizationID
+			}
+		}
+
+		switch {
+		case defaultValue != "":
+			value = types.StringValue(defaultValue)
+		case id.Required && client == nil:
+			continue
+		case id.Required:
+			resp.Diagnostics.AddAttributeError(
+				id.Path,
+				"Missing Attribute Value",
+				fmt.Sprintf("The attribute %s is required unless the provider configures %s.", id.Path, attribute),
+			)
+			continue
+		default:
+			// Left unknown for the API to pick, e.g. the team of the token
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, id.Path, value)...)
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// versionPrivateKey is the private state key holding the version of the
+// object a resource last read or wrote, see objectVersion
+const versionPrivateKey = "version"
+
+// objectVersion identifies the state of a Make object that Terraform last
+// saw, so that updates do not overwrite changes made in the meantime, e.g. in
+// the Make UI during an apply
+type objectVersion struct {
+	// ETag of the object, sent as If-Match when the API supports it
+	ETag string `json:"etag,omitempty"`
+
+	// UpdatedAt is the last modification time of the object, compared before
+	// writing when the API returns no ETag
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// privateStateGetter and privateStateSetter are implemented by the private
+// state of resource requests and responses
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getObjectVersion returns the version stored in private state, which is
+// empty for objects not read since the provider started tracking versions
+func getObjectVersion(ctx context.Context, private privateStateGetter) (objectVersion, diag.Diagnostics) {
+	var version objectVersion
+
+	value, diags := private.GetKey(ctx, versionPrivateKey)
+	if diags.HasError() || value == nil {
+		return version, diags
+	}
+
+	if err := json.Unmarshal(value, &version); err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to decode object version, got error: %s", err))
+	}
+
+	return version, diags
+}
+
+// setObjectVersion stores the version in private state
+func setObjectVersion(ctx context.Context, private privateStateSetter, version objectVersion) diag.Diagnostics {
+	if version == (objectVersion{}) {
+		return private.SetKey(ctx, versionPrivateKey, nil)
+	}
+
+	value, err := json.Marshal(version)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode object version, got error: %s", err))
+		return diags
+	}
+
+	return private.SetKey(ctx, versionPrivateKey, value)
+}
+
+// addObjectChangedError reports an update refused because the object was
+// changed since Terraform last read it
+func addObjectChangedError(diags *diag.Diagnostics, object, id string) {
+	diags.AddError(
+		"Object Changed Outside Terraform",
+		fmt.Sprintf("The %s with ID %s was changed in Make since Terraform last read it, e.g. in the Make UI "+
+			"during the apply, so it was not updated to avoid overwriting those changes. Run terraform plan to "+
+			"review the differences and apply again.", object, id),
+	)
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runStringFunction(t *testing.T, f function.Function, args ...attr.Value) string {
+	t.Helper()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData(args),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("Unexpected function error: %s", resp.Error)
+	}
+
+	result, ok := resp.Result.Value().(types.String)
+	if !ok {
+		t.Fatalf("Expected a string result, got %T", resp.Result.Value())
+	}
+
+	return result.ValueString()
+}
+
+func TestBase64URLEncodeFunction(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"hello", "aGVsbG8"},
+		{"subjects?_d", "c3ViamVjdHM_X2Q"},
+		{"\xfb\xff", "-_8"},
+	}
+
+	for _, test := range tests {
+		got := runStringFunction(t, NewBase64URLEncodeFunction(), types.StringValue(test.input))
+		if got != test.expected {
+			t.Errorf("Expected base64url_encode(%q) to be '%s', got '%s'", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestQueryEncodeFunction(t *testing.T) {
+	values := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"tenant": types.StringValue("acme corp"),
+		"event":  types.StringValue("order.created"),
+		"filter": types.StringValue("a&b=c"),
+	})
+
+	got := runStringFunction(t, NewQueryEncodeFunction(), values)
+
+	expected := "event=order.created&filter=a%26b%3Dc&tenant=acme+corp"
+	if got != expected {
+		t.Errorf("Expected query_encode to be '%s', got '%s'", expected, got)
+	}
+}
+
+func TestQueryEncodeFunctionEmpty(t *testing.T) {
+	values := types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	if got := runStringFunction(t, NewQueryEncodeFunction(), values); got != "" {
+		t.Errorf("Expected query_encode of an empty map to be empty, got '%s'", got)
+	}
+}
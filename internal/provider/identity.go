@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Resources that can be imported implement resource.ResourceWithIdentity, so
+// that Terraform 1.12 and later track them by the identifiers of the object in
+// Make and can import them with an identity instead of an import ID. The
+// identity attributes are state attributes of the same name, which setIdentity
+// copies after every operation. Objects owned by a team or organization also
+// report its ID, which is optional when importing.
+
+// setIdentity sets the identity of a resource from the attributes of the same
+// name in its state. It must be deferred by the operations returning state,
+// e.g.:
+//
+//	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+//
+// The identity is left as it is while the state does not know all identity
+// attributes required for import, e.g. after the object was not found.
+func setIdentity(ctx context.Context, state *tfsdk.State, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics) {
+	// Terraform before 1.12 does not support resource identity
+	if identity == nil || state.Raw.IsNull() {
+		return
+	}
+
+	attributes := identity.Schema.GetAttributes()
+	values := make(map[string]tftypes.Value, len(attributes))
+	for name, attribute := range attributes {
+		value, _, err := tftypes.WalkAttributePath(state.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+		if err != nil {
+			diags.AddError(
+				"Unable to Set Resource Identity",
+				fmt.Sprintf("The identity attribute %s is not in the state: %s. Please report this issue to the provider developers.", name, err),
+			)
+			return
+		}
+
+		stateValue, ok := value.(tftypes.Value)
+		if !ok || !stateValue.IsFullyKnown() {
+			return
+		}
+		if stateValue.IsNull() {
+			if attribute.IsRequiredForImport() {
+				return
+			}
+			stateValue = tftypes.NewValue(attribute.GetType().TerraformType(ctx), nil)
+		}
+		values[name] = stateValue
+	}
+
+	identity.Raw = tftypes.NewValue(identity.Schema.Type().TerraformType(ctx), values)
+}
+
+// identityImportID returns the import ID of a resource, which is built from
+// its identity when it is imported by identity: the given identity attributes
+// joined by "/", in the format of the import ID of the resource. Attributes
+// optional for import are left out when they are not set.
+func identityImportID(req resource.ImportStateRequest, diags *diag.Diagnostics, names ...string) string {
+	if req.ID != "" || req.Identity == nil || req.Identity.Raw.IsNull() {
+		return req.ID
+	}
+
+	attributes := req.Identity.Schema.GetAttributes()
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		value, _, err := tftypes.WalkAttributePath(req.Identity.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+		if identityValue, ok := value.(tftypes.Value); err == nil && ok && identityValue.IsNull() && attributes[name].IsOptionalForImport() {
+			continue
+		}
+
+		var part string
+		if err == nil {
+			part, err = identityString(value)
+		}
+		if err != nil {
+			diags.AddError("Invalid Import Identity", fmt.Sprintf("Unable to read the %s identity attribute: %s", name, err))
+			return ""
+		}
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// identityString returns an identity attribute value in its import ID form
+func identityString(value interface{}) (string, error) {
+	identityValue, ok := value.(tftypes.Value)
+	if !ok || identityValue.IsNull() || !identityValue.IsKnown() {
+		return "", fmt.Errorf("missing value")
+	}
+
+	switch {
+	case identityValue.Type().Is(tftypes.String):
+		var s string
+		err := identityValue.As(&s)
+		return s, err
+	case identityValue.Type().Is(tftypes.Number):
+		var n big.Float
+		err := identityValue.As(&n)
+		return n.Text('f', -1), err
+	default:
+		return "", fmt.Errorf("unsupported type %s", identityValue.Type())
+	}
+}
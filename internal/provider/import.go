@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importScopedID imports a resource by its ID, optionally prefixed by the ID
+// of the team or organization it belongs to, e.g. <team_id>/<id>. The prefix
+// sets the parent attribute, which is otherwise left for Read to fill in from
+// the API.
+func importScopedID(ctx context.Context, id string, parent path.Path, resp *resource.ImportStateResponse) {
+	parentID, objectID, scoped := strings.Cut(id, "/")
+	if !scoped {
+		objectID = id
+	}
+
+	if objectID == "" || (scoped && (parentID == "" || strings.Contains(objectID, "/"))) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <id> or <%s>/<id>. Got: %q", parent, id),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), objectID)...)
+	if scoped {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, parent, parentID)...)
+	}
+}
+
+// importNamePrefix marks import IDs giving the name of the object instead of
+// its ID, e.g. name=Invoicing
+const importNamePrefix = "name="
+
+// importCandidate is an object an import ID given by name may resolve to
+type importCandidate struct {
+	ID   string
+	Name string
+}
+
+// importLister lists the objects of a team an import ID given by name may
+// resolve to
+type importLister func(client MakeAPI, ctx context.Context, teamID string) ([]importCandidate, error)
+
+// importCandidates adapts a list method of MakeAPI, e.g. MakeAPI.ListScenarios,
+// to ResolveImportName
+func importCandidates[T any](list func(MakeAPI, context.Context, string) ([]T, error), candidate func(T) importCandidate) importLister {
+	return func(client MakeAPI, ctx context.Context, teamID string) ([]importCandidate, error) {
+		items, err := list(client, ctx, teamID)
+		if err != nil {
+			return nil, err
+		}
+
+		candidates := make([]importCandidate, len(items))
+		for i, item := range items {
+			candidates[i] = candidate(item)
+		}
+
+		return candidates, nil
+	}
+}
+
+// ResolveImportName resolves an import ID of the form [<team_id>/]name=<name>
+// to the import ID <team_id>/<id> of the only object of the team with that
+// name, listed by list. The team defaults to the provider default_team_id.
+// Other import IDs are returned as they are.
+func (c *apiClient) ResolveImportName(ctx context.Context, id, kind string, list importLister) (string, error) {
+	// Names may contain slashes, the team ID may not
+	teamID, name := "", id
+	if !strings.HasPrefix(id, importNamePrefix) {
+		teamID, name, _ = strings.Cut(id, "/")
+	}
+
+	name, byName := strings.CutPrefix(name, importNamePrefix)
+	if !byName {
+		return id, nil
+	}
+
+	if c == nil {
+		return "", errors.New("the provider is not configured")
+	}
+	if name == "" {
+		return "", fmt.Errorf("missing %s name in import ID %q", kind, id)
+	}
+	if teamID == "" {
+		teamID = c.DefaultTeamID
+	}
+	if teamID == "" {
+		return "", fmt.Errorf("importing by name needs the team to search, given as <team_id>/%s%s or by the provider default_team_id", importNamePrefix, name)
+	}
+
+	candidates, err := list(c, ctx, teamID)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, candidate := range candidates {
+		if candidate.Name == name {
+			ids = append(ids, candidate.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s named %q in team %s", kind, name, teamID)
+	case 1:
+		return teamID + "/" + ids[0], nil
+	default:
+		return "", fmt.Errorf("%d %ss are named %q in team %s (IDs %s), import one of them by ID", len(ids), kind, name, teamID, strings.Join(ids, ", "))
+	}
+}
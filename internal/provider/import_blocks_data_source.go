@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ImportBlocksDataSource{}
+
+func NewImportBlocksDataSource() datasource.DataSource {
+	return &ImportBlocksDataSource{}
+}
+
+// ImportBlocksDataSource defines the data source implementation.
+type ImportBlocksDataSource struct {
+	client MakeAPI
+}
+
+// ImportBlocksDataSourceModel describes the data source data model.
+type ImportBlocksDataSourceModel struct {
+	TeamId        types.String `tfsdk:"team_id"`
+	ResourceTypes types.Set    `tfsdk:"resource_types"`
+	ExcludeIds    types.Set    `tfsdk:"exclude_ids"`
+	Resources     types.List   `tfsdk:"resources"`
+	ImportBlocks  types.String `tfsdk:"import_blocks"`
+	Config        types.String `tfsdk:"config"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// importBlockAttrTypes are the attribute types of an object in the resources list
+var importBlockAttrTypes = map[string]attr.Type{
+	"resource_type": types.StringType,
+	"id":            types.StringType,
+	"name":          types.StringType,
+	"address":       types.StringType,
+	"import_block":  types.StringType,
+	"config":        types.StringType,
+}
+
+// importBlockResourceTypes are the resources the data source generates
+// import blocks for
+var importBlockResourceTypes = []string{"make_connection", "make_scenario", "make_webhook"}
+
+// adoptableObject is an object of the team to generate an import block and a
+// skeleton configuration for
+type adoptableObject struct {
+	ResourceType string
+	ID           string
+	Name         string
+
+	// Attributes are the configured attributes of the skeleton besides name,
+	// team_id and auth_profile, in order
+	Attributes [][2]string
+}
+
+func (d *ImportBlocksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import_blocks"
+}
+
+func (d *ImportBlocksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Generates `import` blocks and skeleton resource configurations for the scenarios, " +
+			"connections and webhooks of a Make.com team, to adopt objects created outside of Terraform. Write them " +
+			"to a file, e.g. with `terraform output -raw`, and run `terraform plan` to review the attributes to add " +
+			"to the skeletons.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team whose objects are listed",
+				Required:            true,
+			},
+			"resource_types": schema.SetAttribute{
+				MarkdownDescription: "Resource types to generate import blocks for, among `make_connection`, " +
+					"`make_scenario` and `make_webhook`. Defaults to all of them.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(importBlockResourceTypes...)),
+				},
+			},
+			"exclude_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of objects to leave out, e.g. those already managed by Terraform",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "Objects to adopt, sorted by resource type and name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "Resource type managing the object",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Object identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the object",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "Resource address the object is imported to, named after the object",
+							Computed:            true,
+						},
+						"import_block": schema.StringAttribute{
+							MarkdownDescription: "`import` block of the object",
+							Computed:            true,
+						},
+						"config": schema.StringAttribute{
+							MarkdownDescription: "Skeleton resource configuration of the object",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"import_blocks": schema.StringAttribute{
+				MarkdownDescription: "`import` blocks of all objects",
+				Computed:            true,
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "Skeleton resource configurations of all objects",
+				Computed:            true,
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *ImportBlocksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ImportBlocksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_import_blocks", "read", nil, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data ImportBlocksDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	teamID := data.TeamId.ValueString()
+
+	resourceTypes := importBlockResourceTypes
+	if !data.ResourceTypes.IsNull() {
+		resourceTypes = nil
+		resp.Diagnostics.Append(data.ResourceTypes.ElementsAs(ctx, &resourceTypes, false)...)
+	}
+
+	var excludeIDs []string
+	if !data.ExcludeIds.IsNull() {
+		resp.Diagnostics.Append(data.ExcludeIds.ElementsAs(ctx, &excludeIDs, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var objects []adoptableObject
+	for _, resourceType := range resourceTypes {
+		switch resourceType {
+		case "make_connection":
+			connections, err := d.client.ListConnections(ctx, teamID)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list connections, got error: %s", err))
+				return
+			}
+			for _, connection := range connections {
+				objects = append(objects, adoptableObject{
+					ResourceType: resourceType,
+					ID:           connection.ID.String(),
+					Name:         connection.Name,
+					Attributes:   [][2]string{{"app_name", hclString(connection.AppName)}},
+				})
+			}
+		case "make_scenario":
+			scenarios, err := d.client.ListScenarios(ctx, teamID)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list scenarios, got error: %s", err))
+				return
+			}
+			for _, scenario := range scenarios {
+				// The blueprint stays edited in the Make UI until it is
+				// added to the configuration
+				objects = append(objects, adoptableObject{
+					ResourceType: resourceType,
+					ID:           scenario.ID.String(),
+					Name:         scenario.Name,
+					Attributes: [][2]string{
+						{"active", fmt.Sprint(scenario.Active)},
+						{"manage_blueprint", "false"},
+					},
+				})
+			}
+		case "make_webhook":
+			webhooks, err := d.client.ListWebhooks(ctx, teamID)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list webhooks, got error: %s", err))
+				return
+			}
+			for _, webhook := range webhooks {
+				attributes := [][2]string{{"active", fmt.Sprint(webhook.Active)}}
+				if webhook.Type != "" {
+					attributes = append(attributes, [2]string{"type", hclString(webhook.Type)})
+				}
+				objects = append(objects, adoptableObject{
+					ResourceType: resourceType,
+					ID:           webhook.ID.String(),
+					Name:         webhook.Name,
+					Attributes:   attributes,
+				})
+			}
+		}
+	}
+
+	objects = excludeObjects(objects, excludeIDs)
+	sort.SliceStable(objects, func(i, j int) bool {
+		if objects[i].ResourceType != objects[j].ResourceType {
+			return objects[i].ResourceType < objects[j].ResourceType
+		}
+		return objects[i].Name < objects[j].Name
+	})
+
+	// Map the objects to their import blocks and skeletons
+	names := make(map[string]bool, len(objects))
+	values := make([]attr.Value, 0, len(objects))
+	var importBlocks, config []string
+	for _, object := range objects {
+		address := object.ResourceType + "." + uniqueResourceName(object, names)
+
+		importBlock := fmt.Sprintf("import {\n  to = %s\n  id = %s\n}\n", address, hclString(teamID+"/"+object.ID))
+		skeleton := resourceSkeleton(object, address, teamID, data.AuthProfile)
+
+		values = append(values, types.ObjectValueMust(importBlockAttrTypes, map[string]attr.Value{
+			"resource_type": types.StringValue(object.ResourceType),
+			"id":            types.StringValue(object.ID),
+			"name":          types.StringValue(object.Name),
+			"address":       types.StringValue(address),
+			"import_block":  types.StringValue(importBlock),
+			"config":        types.StringValue(skeleton),
+		}))
+		importBlocks = append(importBlocks, importBlock)
+		config = append(config, skeleton)
+	}
+
+	data.Resources = types.ListValueMust(types.ObjectType{AttrTypes: importBlockAttrTypes}, values)
+	data.ImportBlocks = types.StringValue(strings.Join(importBlocks, "\n"))
+	data.Config = types.StringValue(strings.Join(config, "\n"))
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read an import blocks data source", map[string]interface{}{"count": len(objects)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// excludeObjects removes the objects with the given IDs
+func excludeObjects(objects []adoptableObject, ids []string) []adoptableObject {
+	if len(ids) == 0 {
+		return objects
+	}
+
+	excluded := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		excluded[id] = true
+	}
+
+	kept := objects[:0]
+	for _, object := range objects {
+		if !excluded[object.ID] {
+			kept = append(kept, object)
+		}
+	}
+
+	return kept
+}
+
+// uniqueResourceName returns the resource name of an object derived from its
+// name, e.g. "Invoicing (EU)" becomes invoicing_eu. Names already taken by
+// another object of the same type are suffixed by the object ID.
+func uniqueResourceName(object adoptableObject, taken map[string]bool) string {
+	var name strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(object.Name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if underscore && name.Len() > 0 {
+				name.WriteByte('_')
+			}
+			name.WriteRune(r)
+			underscore = false
+		default:
+			underscore = true
+		}
+	}
+
+	resourceName := name.String()
+	if resourceName == "" || resourceName[0] >= '0' && resourceName[0] <= '9' {
+		resourceName = strings.TrimPrefix(object.ResourceType, "make_") + "_" + resourceName
+		resourceName = strings.TrimSuffix(resourceName, "_")
+	}
+
+	if taken[object.ResourceType+"."+resourceName] {
+		resourceName += "_" + strings.Map(func(r rune) rune {
+			if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+				return r
+			}
+			return '_'
+		}, object.ID)
+	}
+	taken[object.ResourceType+"."+resourceName] = true
+
+	return resourceName
+}
+
+// resourceSkeleton returns the resource block of an object with the
+// attributes needed to import it, aligned like terraform fmt does
+func resourceSkeleton(object adoptableObject, address, teamID string, authProfile types.String) string {
+	attributes := [][2]string{{"name", hclString(object.Name)}, {"team_id", hclString(teamID)}}
+	attributes = append(attributes, object.Attributes...)
+	if !authProfile.IsNull() {
+		attributes = append(attributes, [2]string{"auth_profile", hclString(authProfile.ValueString())})
+	}
+
+	width := 0
+	for _, attribute := range attributes {
+		width = max(width, len(attribute[0]))
+	}
+
+	resourceType, resourceName, _ := strings.Cut(address, ".")
+
+	var skeleton strings.Builder
+	fmt.Fprintf(&skeleton, "resource %q %q {\n", resourceType, resourceName)
+	for _, attribute := range attributes {
+		fmt.Fprintf(&skeleton, "  %-*s = %s\n", width, attribute[0], attribute[1])
+	}
+	skeleton.WriteString("}\n")
+
+	return skeleton.String()
+}
+
+// hclString returns a string as an HCL string literal, escaping template
+// sequences so that names are not interpolated
+func hclString(s string) string {
+	var literal strings.Builder
+	encoder := json.NewEncoder(&literal)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s)
+
+	quoted := strings.TrimSuffix(literal.String(), "\n")
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	quoted = strings.ReplaceAll(quoted, "%{", "%%{")
+
+	return quoted
+}
@@ -0,0 +1,490 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// testMockAPIToken is the API token accepted by the mock Make API
+const testMockAPIToken = "mock-api-token"
+
+// mockCollection is a collection of objects served by the mock Make API
+type mockCollection struct {
+	// Path of the collection, with * standing for the ID of a parent object
+	Path string
+
+	// Key is the field identifying the objects, which is a generated "id"
+	// unless set
+	Key string
+
+	// Parent is the field receiving the ID of the parent object, if any
+	Parent string
+}
+
+// mockCollections are the collections the mock Make API implements CRUD
+// endpoints for. Acceptance tests of other endpoints need the real API, see
+// testAccPreCheckLive.
+var mockCollections = []mockCollection{
+	{Path: "v2/scenarios"},
+	{Path: "v2/connections"},
+	{Path: "v2/teams"},
+	{Path: "v2/organizations"},
+	{Path: "v2/data-stores"},
+	{Path: "v2/functions"},
+	{Path: "v2/keys"},
+	{Path: "v2/teams/*/variables", Key: "name", Parent: "team_id"},
+	{Path: "v2/webhooks"},
+}
+
+// mockAppConnections is the apps catalog of the mock Make API, serving the
+// connection of each app at v2/apps/<name>/connection
+var mockAppConnections = map[string]makeapi.AppConnectionResponse{
+	"postgres": {
+		Name:  "postgres",
+		Label: "PostgreSQL",
+		Parameters: []makeapi.AppParameter{
+			{Name: "host", Label: "Host", Type: "text", Required: true},
+			{Name: "port", Label: "Port", Type: "uinteger"},
+			{Name: "ssl", Label: "Use SSL", Type: "boolean"},
+			{Name: "password", Label: "Password", Type: "password"},
+		},
+	},
+}
+
+// mockObject is an object stored by the mock Make API
+type mockObject struct {
+	Fields  map[string]interface{}
+	Version int
+}
+
+// response returns the fields of the object returned by the API. Secret
+// values are never returned, like by the real API.
+func (o *mockObject) response() map[string]interface{} {
+	if secret, _ := o.Fields["is_secret"].(bool); !secret {
+		return o.Fields
+	}
+
+	fields := maps.Clone(o.Fields)
+	delete(fields, "value")
+
+	return fields
+}
+
+// etag returns the ETag of the current version of the object
+func (o *mockObject) etag() string {
+	return strconv.Quote(strconv.Itoa(o.Version))
+}
+
+// mockMakeAPI is an in-process Make API keeping objects in memory, so that
+// the lifecycle of resources can be tested without credentials. Objects are
+// created by POST to their collection and read, updated and deleted at the
+// collection path followed by their ID. Responses carry ETags, and updates
+// honour If-Match, like the real API.
+type mockMakeAPI struct {
+	mu      sync.Mutex
+	objects map[string]*mockObject
+
+	// order lists the object paths in creation order, for listing
+	order  []string
+	nextID int
+
+	// requests lists the method and path of the modifying requests received,
+	// e.g. "PATCH v2/webhooks/1", until checked by testAccCheckMockRequests
+	requests []string
+}
+
+// newMockMakeAPI returns a mock Make API server, which the caller closes
+func newMockMakeAPI() *httptest.Server {
+	return httptest.NewServer(&mockMakeAPI{objects: make(map[string]*mockObject)})
+}
+
+func (m *mockMakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Token "+testMockAPIToken {
+		mockError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	urlPath := strings.Trim(r.URL.Path, "/")
+	if r.Method != http.MethodGet {
+		m.requests = append(m.requests, r.Method+" "+urlPath)
+	}
+
+	if app, ok := strings.CutPrefix(urlPath, "v2/apps/"); ok && r.Method == http.MethodGet && strings.HasSuffix(app, "/connection") {
+		connection, ok := mockAppConnections[strings.TrimSuffix(app, "/connection")]
+		if !ok {
+			mockError(w, http.StatusNotFound, "App not found")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"connection": connection})
+		return
+	}
+
+	// Scenarios are stopped without running executions to wait for
+	if scenario, ok := strings.CutPrefix(urlPath, "v2/scenarios/"); ok && strings.Count(scenario, "/") == 1 {
+		m.scenarioAction(w, r, urlPath)
+		return
+	}
+
+	// Webhooks have endpoints changing their state without an update
+	if webhook, ok := strings.CutPrefix(urlPath, "v2/webhooks/"); ok && strings.Count(webhook, "/") == 1 {
+		m.webhookAction(w, r, urlPath)
+		return
+	}
+
+	if collection, parentID, ok := matchMockCollection(urlPath); ok {
+		switch r.Method {
+		case http.MethodGet:
+			m.list(w, r, urlPath)
+		case http.MethodPost:
+			m.create(w, r, urlPath, collection, parentID)
+		default:
+			mockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	if _, _, ok := matchMockCollection(path.Dir(urlPath)); !ok {
+		mockError(w, http.StatusNotFound, "Endpoint not implemented by the mock API")
+		return
+	}
+
+	object, ok := m.objects[urlPath]
+	if !ok {
+		mockError(w, http.StatusNotFound, "Object not found")
+		return
+	}
+
+	etag := object.etag()
+	if match := r.Header.Get("If-Match"); match != "" && match != etag {
+		mockError(w, http.StatusPreconditionFailed, "Object was changed")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if r.Header.Get("If-None-Match") == etag {
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	case http.MethodPut, http.MethodPatch:
+		var fields map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+			mockError(w, http.StatusBadRequest, "Invalid JSON")
+			return
+		}
+
+		// Fields sent as null are cleared
+		for name, value := range fields {
+			if value == nil {
+				delete(object.Fields, name)
+			} else {
+				object.Fields[name] = value
+			}
+		}
+		object.Version++
+	case http.MethodDelete:
+		delete(m.objects, urlPath)
+		m.order = slices.DeleteFunc(m.order, func(objectPath string) bool { return objectPath == urlPath })
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		mockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	mockObjectResponse(w, object)
+}
+
+// scenarioAction serves the stop and executions endpoints of a scenario
+func (m *mockMakeAPI) scenarioAction(w http.ResponseWriter, r *http.Request, urlPath string) {
+	object, ok := m.objects[path.Dir(urlPath)]
+	if !ok {
+		mockError(w, http.StatusNotFound, "Object not found")
+		return
+	}
+
+	switch {
+	case path.Base(urlPath) == "stop" && r.Method == http.MethodPost:
+		object.Fields["is_active"] = false
+		object.Version++
+		mockObjectResponse(w, object)
+	case path.Base(urlPath) == "executions" && r.Method == http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"executions": []interface{}{}})
+	default:
+		mockError(w, http.StatusNotFound, "Endpoint not implemented by the mock API")
+	}
+}
+
+// webhookAction serves the endpoints enabling, disabling and rotating the URL
+// of a webhook, and starting and stopping its learning mode
+func (m *mockMakeAPI) webhookAction(w http.ResponseWriter, r *http.Request, urlPath string) {
+	object, ok := m.objects[path.Dir(urlPath)]
+	if !ok {
+		mockError(w, http.StatusNotFound, "Object not found")
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		mockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	if match := r.Header.Get("If-Match"); match != "" && match != object.etag() {
+		mockError(w, http.StatusPreconditionFailed, "Object was changed")
+		return
+	}
+
+	switch path.Base(urlPath) {
+	case "enable":
+		object.Fields["active"] = true
+	case "disable":
+		object.Fields["active"] = false
+	case "learn-start":
+		object.Fields["learning"] = true
+	case "learn-stop":
+		object.Fields["learning"] = false
+	case "rotate-url":
+		object.Fields["url"] = mockWebhookURL(path.Base(path.Dir(urlPath)), object.Version+1)
+	default:
+		mockError(w, http.StatusNotFound, "Endpoint not implemented by the mock API")
+		return
+	}
+	object.Version++
+
+	mockObjectResponse(w, object)
+}
+
+// create stores the object posted to a collection
+func (m *mockMakeAPI) create(w http.ResponseWriter, r *http.Request, urlPath string, collection mockCollection, parentID string) {
+	var fields map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+		mockError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	key := collection.Key
+	if key == "" {
+		key = "id"
+		m.nextID++
+		fields["id"] = strconv.Itoa(m.nextID)
+	}
+	if collection.Parent != "" {
+		fields[collection.Parent] = parentID
+	}
+
+	id := fmt.Sprint(fields[key])
+	if fields[key] == nil || id == "" {
+		mockError(w, http.StatusBadRequest, fmt.Sprintf("Missing %s", key))
+		return
+	}
+
+	objectPath := urlPath + "/" + id
+	if _, exists := m.objects[objectPath]; exists {
+		mockError(w, http.StatusConflict, "Object already exists")
+		return
+	}
+
+	// Webhooks are given the address they receive requests at
+	if urlPath == "v2/webhooks" {
+		fields["url"] = mockWebhookURL(id, 1)
+	}
+
+	object := &mockObject{Fields: fields, Version: 1}
+	m.objects[objectPath] = object
+	m.order = append(m.order, objectPath)
+
+	mockObjectResponse(w, object)
+}
+
+// list returns the page of the objects of a collection selected by the
+// pg[offset] and pg[limit] parameters, keyed by the collection name with
+// underscores for dashes. Other query parameters filter the objects by their
+// fields.
+func (m *mockMakeAPI) list(w http.ResponseWriter, r *http.Request, urlPath string) {
+	query := r.URL.Query()
+
+	items := []map[string]interface{}{}
+	for _, objectPath := range m.order {
+		if path.Dir(objectPath) != urlPath {
+			continue
+		}
+		object := m.objects[objectPath]
+
+		matches := true
+		for name, values := range query {
+			if !strings.HasPrefix(name, "pg[") && fmt.Sprint(object.Fields[name]) != values[0] {
+				matches = false
+			}
+		}
+		if matches {
+			items = append(items, object.response())
+		}
+	}
+
+	offset, _ := strconv.Atoi(query.Get("pg[offset]"))
+	limit, err := strconv.Atoi(query.Get("pg[limit]"))
+	if err != nil {
+		limit = len(items)
+	}
+	items = items[min(offset, len(items)):min(offset+limit, len(items))]
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{strings.ReplaceAll(path.Base(urlPath), "-", "_"): items})
+}
+
+// matchMockCollection returns the collection a path is the collection
+// endpoint of, with the ID of its parent object
+func matchMockCollection(urlPath string) (mockCollection, string, bool) {
+	for _, collection := range mockCollections {
+		pattern := strings.Split(collection.Path, "/")
+		segments := strings.Split(urlPath, "/")
+		if len(pattern) != len(segments) {
+			continue
+		}
+
+		parentID, matches := "", true
+		for i, segment := range pattern {
+			switch {
+			case segment == "*":
+				parentID = segments[i]
+			case segment != segments[i]:
+				matches = false
+			}
+		}
+		if matches {
+			return collection, parentID, true
+		}
+	}
+
+	return mockCollection{}, "", false
+}
+
+// mockWebhookURL returns the URL of a webhook, which changes with every
+// rotation
+func mockWebhookURL(id string, version int) string {
+	return fmt.Sprintf("https://hook.mock.make.com/%s-%d", id, version)
+}
+
+// mockObjectResponse writes an object with its ETag
+func mockObjectResponse(w http.ResponseWriter, object *mockObject) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", object.etag())
+	_ = json.NewEncoder(w).Encode(object.response())
+}
+
+// mockError writes an error response of the Make API
+func mockError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(makeapi.ErrorResponse{Message: message, Code: status})
+}
+
+// testAccCheckMockField checks the JSON encoding of a field of the object the
+// mock Make API stores for a resource, i.e. what the provider sent for it, at
+// the collection path followed by the ID of the resource. A null value checks
+// that the field is unset. Against the real API, nothing is checked.
+func testAccCheckMockField(t *testing.T, resourceName, collectionPath, field, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !testAccUseMockAPI(t) {
+			return nil
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		api := testAccMockAPI()
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		object, ok := api.objects[collectionPath+"/"+rs.Primary.ID]
+		if !ok {
+			return fmt.Errorf("%s/%s not found in the mock API", collectionPath, rs.Primary.ID)
+		}
+
+		actual, err := json.Marshal(object.Fields[field])
+		if err != nil {
+			return err
+		}
+
+		// Encoding the decoded value sorts its keys like those of the field
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			return err
+		}
+		expected, err := json.Marshal(decoded)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(actual, expected) {
+			return fmt.Errorf("expected %s of %s to be %s, got %s", field, resourceName, expected, actual)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckMockRequests checks the modifying requests the mock Make API
+// received for the object of a resource, or its endpoints, since the previous
+// check, e.g. "POST v2/webhooks/%s/disable" with %s standing for the ID of the
+// resource. Against the real API, nothing is checked.
+func testAccCheckMockRequests(t *testing.T, resourceName, collectionPath string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !testAccUseMockAPI(t) {
+			return nil
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		api := testAccMockAPI()
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		objectPath := collectionPath + "/" + rs.Primary.ID
+		actual := []string{}
+		for _, request := range api.requests {
+			_, requestPath, _ := strings.Cut(request, " ")
+			if requestPath == objectPath || strings.HasPrefix(requestPath, objectPath+"/") {
+				actual = append(actual, request)
+			}
+		}
+		api.requests = nil
+
+		want := []string{}
+		for _, request := range expected {
+			want = append(want, fmt.Sprintf(request, rs.Primary.ID))
+		}
+
+		if !slices.Equal(actual, want) {
+			return fmt.Errorf("expected the requests %q for %s, got %q", want, resourceName, actual)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NotificationPreferencesResource{}
+var _ resource.ResourceWithImportState = &NotificationPreferencesResource{}
+var _ resource.ResourceWithConfigValidators = &NotificationPreferencesResource{}
+var _ resource.ResourceWithModifyPlan = &NotificationPreferencesResource{}
+var _ resource.ResourceWithUpgradeState = &NotificationPreferencesResource{}
+var _ resource.ResourceWithIdentity = &NotificationPreferencesResource{}
+
+// notificationPreferencesUserID identifies the preferences of the user the
+// API token belongs to
+const notificationPreferencesUserID = "me"
+
+func NewNotificationPreferencesResource() resource.Resource {
+	return &NotificationPreferencesResource{}
+}
+
+// NotificationPreferencesResource defines the resource implementation.
+type NotificationPreferencesResource struct {
+	client MakeAPI
+}
+
+// NotificationPreferencesResourceModel describes the resource data model.
+type NotificationPreferencesResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	TeamId                types.String `tfsdk:"team_id"`
+	ScenarioErrors        types.Bool   `tfsdk:"scenario_errors"`
+	ScenarioWarnings      types.Bool   `tfsdk:"scenario_warnings"`
+	ScenarioDeactivations types.Bool   `tfsdk:"scenario_deactivations"`
+	Frequency             types.String `tfsdk:"frequency"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *NotificationPreferencesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_preferences"
+}
+
+func (r *NotificationPreferencesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Email notification preferences for scenario errors and warnings, either of a team " +
+			"or of the user the API token belongs to. Preferences that are not configured keep their current value, " +
+			"and destroying the resource only removes it from the Terraform state.",
+
+		Version: 0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the preferences: the team ID, or `me` for the preferences of the user",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team whose preferences are managed. When not set, the preferences " +
+					"of the user the API token belongs to are managed.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scenario_errors": schema.BoolAttribute{
+				MarkdownDescription: "Whether to notify about scenario runs that ended with an error",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scenario_warnings": schema.BoolAttribute{
+				MarkdownDescription: "Whether to notify about scenario runs that ended with a warning",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scenario_deactivations": schema.BoolAttribute{
+				MarkdownDescription: "Whether to notify about scenarios deactivated after repeated errors",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"frequency": schema.StringAttribute{
+				MarkdownDescription: "How often notifications are sent: `immediately`, `daily` or `weekly`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("immediately", "daily", "weekly"),
+				},
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true}),
+		},
+	}
+}
+
+func (r *NotificationPreferencesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *NotificationPreferencesResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Team ID, or `me` for the preferences of the user",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *NotificationPreferencesResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(path.Root("team_id")),
+	}
+}
+
+func (r *NotificationPreferencesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.CheckReferences(ctx, req.Plan, &resp.Diagnostics,
+		reference{Path: path.Root("team_id"), Endpoint: "v2/teams/%s"},
+	)
+}
+
+func (r *NotificationPreferencesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		r.client = unconfiguredClient
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NotificationPreferencesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_notification_preferences", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data NotificationPreferencesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Update the preferences via API
+	preferences, err := r.client.UpdateNotificationPreferences(ctx, data.TeamId.ValueString(), data.preferences())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification preferences, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.Id = types.StringValue(notificationPreferencesUserID)
+	if !data.TeamId.IsNull() {
+		data.Id = data.TeamId
+	}
+	data.setPreferences(preferences)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a notification preferences resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationPreferencesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_notification_preferences", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data NotificationPreferencesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the preferences from the API
+	preferences, err := r.client.GetNotificationPreferences(ctx, data.TeamId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification preferences, got error: %s", err))
+		return
+	}
+
+	// Map API response to Terraform state
+	data.setPreferences(preferences)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationPreferencesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer recoverPanic(ctx, "make_notification_preferences", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data NotificationPreferencesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update the preferences via API
+	preferences, err := r.client.UpdateNotificationPreferences(ctx, data.TeamId.ValueString(), data.preferences())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification preferences, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.setPreferences(preferences)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationPreferencesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_notification_preferences", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	// Notification preferences cannot be deleted, so they are only removed
+	// from the Terraform state
+	tflog.Debug(ctx, "removing notification preferences from state without changing them")
+}
+
+func (r *NotificationPreferencesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The preferences are imported by team ID, or with "me" for the user
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+
+	if req.ID != notificationPreferencesUserID {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), req.ID)...)
+	}
+}
+
+// preferences builds the API representation of the configured preferences.
+// Unknown preferences are left empty so that Make.com keeps their current value.
+func (m NotificationPreferencesResourceModel) preferences() makeapi.NotificationPreferences {
+	preferences := makeapi.NotificationPreferences{}
+
+	if !m.ScenarioErrors.IsUnknown() {
+		preferences.ScenarioErrors = m.ScenarioErrors.ValueBoolPointer()
+	}
+
+	if !m.ScenarioWarnings.IsUnknown() {
+		preferences.ScenarioWarnings = m.ScenarioWarnings.ValueBoolPointer()
+	}
+
+	if !m.ScenarioDeactivations.IsUnknown() {
+		preferences.ScenarioDeactivations = m.ScenarioDeactivations.ValueBoolPointer()
+	}
+
+	if !m.Frequency.IsUnknown() {
+		preferences.Frequency = m.Frequency.ValueString()
+	}
+
+	return preferences
+}
+
+// setPreferences maps the preferences returned by the API to the model
+func (m *NotificationPreferencesResourceModel) setPreferences(preferences *makeapi.NotificationPreferences) {
+	m.ScenarioErrors = types.BoolPointerValue(preferences.ScenarioErrors)
+	m.ScenarioWarnings = types.BoolPointerValue(preferences.ScenarioWarnings)
+	m.ScenarioDeactivations = types.BoolPointerValue(preferences.ScenarioDeactivations)
+	m.Frequency = optionalString(preferences.Frequency)
+}
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganizationAnalyticsDataSource{}
+
+func NewOrganizationAnalyticsDataSource() datasource.DataSource {
+	return &OrganizationAnalyticsDataSource{}
+}
+
+// OrganizationAnalyticsDataSource defines the data source implementation.
+type OrganizationAnalyticsDataSource struct {
+	client MakeAPI
+}
+
+// OrganizationAnalyticsDataSourceModel describes the data source data model.
+type OrganizationAnalyticsDataSourceModel struct {
+	OrganizationId types.String `tfsdk:"organization_id"`
+	From           types.String `tfsdk:"from"`
+	To             types.String `tfsdk:"to"`
+	ScenarioLimit  types.Int64  `tfsdk:"scenario_limit"`
+	Operations     types.Int64  `tfsdk:"operations"`
+	DataTransferMB types.Int64  `tfsdk:"data_transfer_mb"`
+	Days           types.List   `tfsdk:"days"`
+	Scenarios      types.List   `tfsdk:"scenarios"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// scenarioUsageAttrTypes are the attribute types of an entry in the scenarios list
+var scenarioUsageAttrTypes = map[string]attr.Type{
+	"scenario_id":      types.StringType,
+	"scenario_name":    types.StringType,
+	"team_id":          types.StringType,
+	"executions":       types.Int64Type,
+	"errors":           types.Int64Type,
+	"operations":       types.Int64Type,
+	"data_transfer_mb": types.Int64Type,
+}
+
+func (d *OrganizationAnalyticsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_analytics"
+}
+
+func (d *OrganizationAnalyticsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Consumption of a Make.com organization over a period, per day and per scenario, e.g. " +
+			"for capacity planning. Without `from` and `to`, the current billing period is reported.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization",
+				Required:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Start of the period as an RFC 3339 timestamp",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "End of the period as an RFC 3339 timestamp",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"scenario_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of scenarios to return, taking the scenarios that consumed the most operations first",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"operations": schema.Int64Attribute{
+				MarkdownDescription: "Total number of operations consumed in the period",
+				Computed:            true,
+			},
+			"data_transfer_mb": schema.Int64Attribute{
+				MarkdownDescription: "Total data transfer in the period in megabytes",
+				Computed:            true,
+			},
+			"days": schema.ListNestedAttribute{
+				MarkdownDescription: "Consumption per day, oldest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Day of the consumption in `YYYY-MM-DD` format",
+							Computed:            true,
+						},
+						"operations": schema.Int64Attribute{
+							MarkdownDescription: "Number of operations consumed on the day",
+							Computed:            true,
+						},
+						"data_transfer_mb": schema.Int64Attribute{
+							MarkdownDescription: "Data transfer on the day in megabytes",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"scenarios": schema.ListNestedAttribute{
+				MarkdownDescription: "Consumption per scenario, the scenarios that consumed the most operations first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"scenario_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the scenario",
+							Computed:            true,
+						},
+						"scenario_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the scenario",
+							Computed:            true,
+						},
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the team the scenario belongs to",
+							Computed:            true,
+						},
+						"executions": schema.Int64Attribute{
+							MarkdownDescription: "Number of runs of the scenario in the period",
+							Computed:            true,
+						},
+						"errors": schema.Int64Attribute{
+							MarkdownDescription: "Number of runs of the scenario that ended with an error",
+							Computed:            true,
+						},
+						"operations": schema.Int64Attribute{
+							MarkdownDescription: "Number of operations consumed by the scenario",
+							Computed:            true,
+						},
+						"data_transfer_mb": schema.Int64Attribute{
+							MarkdownDescription: "Data transfer of the scenario in megabytes",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *OrganizationAnalyticsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationAnalyticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_organization_analytics", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data OrganizationAnalyticsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	analytics, err := d.client.GetOrganizationAnalytics(ctx, data.OrganizationId.ValueString(), data.From.ValueString(), data.To.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization analytics, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.Operations, data.DataTransferMB, data.Days = dailyUsageValues(analytics.Days)
+
+	scenarios := analytics.Scenarios
+	sort.SliceStable(scenarios, func(i, j int) bool {
+		return scenarios[i].Operations > scenarios[j].Operations
+	})
+
+	if limit := int(data.ScenarioLimit.ValueInt64()); limit > 0 && len(scenarios) > limit {
+		scenarios = scenarios[:limit]
+	}
+
+	scenarioValues := make([]attr.Value, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		scenarioValues = append(scenarioValues, types.ObjectValueMust(scenarioUsageAttrTypes, map[string]attr.Value{
+			"scenario_id":      types.StringValue(scenario.ScenarioID.String()),
+			"scenario_name":    types.StringValue(scenario.ScenarioName),
+			"team_id":          types.StringValue(scenario.TeamID.String()),
+			"executions":       types.Int64Value(scenario.Executions),
+			"errors":           types.Int64Value(scenario.Errors),
+			"operations":       types.Int64Value(scenario.Operations),
+			"data_transfer_mb": types.Int64Value(scenario.DataTransferMB),
+		}))
+	}
+
+	data.Scenarios = types.ListValueMust(types.ObjectType{AttrTypes: scenarioUsageAttrTypes}, scenarioValues)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read an organization analytics data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
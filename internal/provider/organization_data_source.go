@@ -19,13 +19,15 @@ func NewOrganizationDataSource() datasource.DataSource {
 
 // OrganizationDataSource defines the data source implementation.
 type OrganizationDataSource struct {
-	client *MakeAPIClient
+	client MakeAPI
 }
 
 // OrganizationDataSourceModel describes the data source data model.
 type OrganizationDataSourceModel struct {
 	Id   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
 }
 
 func (d *OrganizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,6 +47,7 @@ func (d *OrganizationDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "Name of the organization",
 				Computed:            true,
 			},
+			"auth_profile": authProfileDataSourceAttribute(),
 		},
 	}
 }
@@ -54,12 +57,12 @@ func (d *OrganizationDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*MakeAPIClient)
+	client, ok := req.ProviderData.(MakeAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *MakeAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -69,6 +72,9 @@ func (d *OrganizationDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_organization", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
 	var data OrganizationDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -77,13 +83,15 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
 	org, err := d.client.GetOrganization(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
 		return
 	}
 
-	data.Id = types.StringValue(org.ID)
+	data.Id = types.StringValue(org.ID.String())
 	data.Name = types.StringValue(org.Name)
 
 	tflog.Trace(ctx, "read an organization data source")
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganizationSubscriptionDataSource{}
+
+func NewOrganizationSubscriptionDataSource() datasource.DataSource {
+	return &OrganizationSubscriptionDataSource{}
+}
+
+// OrganizationSubscriptionDataSource defines the data source implementation.
+type OrganizationSubscriptionDataSource struct {
+	client MakeAPI
+}
+
+// OrganizationSubscriptionDataSourceModel describes the data source data model.
+type OrganizationSubscriptionDataSourceModel struct {
+	OrganizationId      types.String `tfsdk:"organization_id"`
+	Plan                types.String `tfsdk:"plan"`
+	OperationsLimit     types.Int64  `tfsdk:"operations_limit"`
+	OperationsUsed      types.Int64  `tfsdk:"operations_used"`
+	OperationsRemaining types.Int64  `tfsdk:"operations_remaining"`
+	DataTransferLimitMB types.Int64  `tfsdk:"data_transfer_limit_mb"`
+	DataTransferUsedMB  types.Int64  `tfsdk:"data_transfer_used_mb"`
+	PeriodStart         types.String `tfsdk:"period_start"`
+	PeriodEnd           types.String `tfsdk:"period_end"`
+	Features            types.Map    `tfsdk:"features"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+func (d *OrganizationSubscriptionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_subscription"
+}
+
+func (d *OrganizationSubscriptionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Subscription plan, operations quota and feature entitlements of a Make.com organization, " +
+			"e.g. to only create resources when the plan allows them.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization",
+				Required:            true,
+			},
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "Name of the subscription plan, e.g. `core` or `enterprise`",
+				Computed:            true,
+			},
+			"operations_limit": schema.Int64Attribute{
+				MarkdownDescription: "Number of operations included in the current billing period",
+				Computed:            true,
+			},
+			"operations_used": schema.Int64Attribute{
+				MarkdownDescription: "Number of operations consumed in the current billing period",
+				Computed:            true,
+			},
+			"operations_remaining": schema.Int64Attribute{
+				MarkdownDescription: "Number of operations left in the current billing period",
+				Computed:            true,
+			},
+			"data_transfer_limit_mb": schema.Int64Attribute{
+				MarkdownDescription: "Data transfer included in the current billing period in megabytes",
+				Computed:            true,
+			},
+			"data_transfer_used_mb": schema.Int64Attribute{
+				MarkdownDescription: "Data transfer consumed in the current billing period in megabytes",
+				Computed:            true,
+			},
+			"period_start": schema.StringAttribute{
+				MarkdownDescription: "Start of the current billing period",
+				Computed:            true,
+			},
+			"period_end": schema.StringAttribute{
+				MarkdownDescription: "End of the current billing period, when the operations quota is reset",
+				Computed:            true,
+			},
+			"features": schema.MapAttribute{
+				MarkdownDescription: "Feature entitlements of the plan keyed by feature name, e.g. `custom_functions`",
+				Computed:            true,
+				ElementType:         types.BoolType,
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *OrganizationSubscriptionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationSubscriptionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_organization_subscription", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data OrganizationSubscriptionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	subscription, err := d.client.GetOrganizationSubscription(ctx, data.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization subscription, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state. Usage can exceed the quota when
+	// overage is allowed, so the remaining operations never go below zero.
+	data.Plan = types.StringValue(subscription.Plan)
+	data.OperationsLimit = types.Int64Value(subscription.OperationsLimit)
+	data.OperationsUsed = types.Int64Value(subscription.OperationsUsed)
+	data.OperationsRemaining = types.Int64Value(max(subscription.OperationsLimit-subscription.OperationsUsed, 0))
+	data.DataTransferLimitMB = types.Int64Value(subscription.DataTransferLimitMB)
+	data.DataTransferUsedMB = types.Int64Value(subscription.DataTransferUsedMB)
+	data.PeriodStart = types.StringValue(subscription.PeriodStart)
+	data.PeriodEnd = types.StringValue(subscription.PeriodEnd)
+
+	features := make(map[string]attr.Value, len(subscription.Features))
+	for name, enabled := range subscription.Features {
+		features[name] = types.BoolValue(enabled)
+	}
+	data.Features = types.MapValueMust(types.BoolType, features)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read an organization subscription data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganizationsDataSource{}
+
+func NewOrganizationsDataSource() datasource.DataSource {
+	return &OrganizationsDataSource{}
+}
+
+// OrganizationsDataSource defines the data source implementation.
+type OrganizationsDataSource struct {
+	client MakeAPI
+}
+
+// OrganizationsDataSourceModel describes the data source data model.
+type OrganizationsDataSourceModel struct {
+	Organizations types.List `tfsdk:"organizations"`
+	Ids           types.Map  `tfsdk:"ids"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// organizationAttrTypes are the attribute types of an organization in the organizations list
+var organizationAttrTypes = map[string]attr.Type{
+	"id":   types.StringType,
+	"name": types.StringType,
+}
+
+func (d *OrganizationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizations"
+}
+
+func (d *OrganizationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "All Make.com organizations the API token has access to, e.g. to drive `for_each` " +
+			"without maintaining lists of organization IDs",
+
+		Attributes: map[string]schema.Attribute{
+			"organizations": schema.ListNestedAttribute{
+				MarkdownDescription: "Organizations the API token has access to, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Organization identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the organization",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				MarkdownDescription: "Organization identifiers keyed by organization name",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *OrganizationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_organizations", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data OrganizationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	organizations, err := d.client.ListOrganizations(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organizations, got error: %s", err))
+		return
+	}
+
+	sort.Slice(organizations, func(i, j int) bool {
+		return organizations[i].Name < organizations[j].Name
+	})
+
+	// Map response to Terraform state
+	organizationValues := make([]attr.Value, 0, len(organizations))
+	ids := make(map[string]attr.Value, len(organizations))
+	for _, org := range organizations {
+		organizationValues = append(organizationValues, types.ObjectValueMust(organizationAttrTypes, map[string]attr.Value{
+			"id":   types.StringValue(org.ID.String()),
+			"name": types.StringValue(org.Name),
+		}))
+		ids[org.Name] = types.StringValue(org.ID.String())
+	}
+
+	data.Organizations = types.ListValueMust(types.ObjectType{AttrTypes: organizationAttrTypes}, organizationValues)
+	data.Ids = types.MapValueMust(types.StringType, ids)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read an organizations data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// requiresReplaceOwner returns a plan modifier which replaces the resource when
+// the team or organization owning it changes, because the Make API cannot move
+// objects between them. The description tells why. An owner missing from the
+// state, e.g. after an import by ID, is filled in from the configuration
+// without replacement.
+func requiresReplaceOwner(description string) planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		description,
+		description,
+	)
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProviderInfoDataSource{}
+
+func NewProviderInfoDataSource() datasource.DataSource {
+	return &ProviderInfoDataSource{}
+}
+
+// ProviderInfoDataSource defines the data source implementation.
+type ProviderInfoDataSource struct {
+	client MakeAPI
+}
+
+// ProviderInfoDataSourceModel describes the data source data model.
+type ProviderInfoDataSourceModel struct {
+	Zone          types.String `tfsdk:"zone"`
+	BaseUrl       types.String `tfsdk:"base_url"`
+	UserId        types.String `tfsdk:"user_id"`
+	UserName      types.String `tfsdk:"user_name"`
+	UserEmail     types.String `tfsdk:"user_email"`
+	Organizations types.List   `tfsdk:"organizations"`
+	Scopes        types.List   `tfsdk:"scopes"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// providerInfoOrganizationAttrTypes are the attribute types of an organization
+// in the organizations list
+var providerInfoOrganizationAttrTypes = map[string]attr.Type{
+	"id":   types.StringType,
+	"name": types.StringType,
+}
+
+func (d *ProviderInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider_info"
+}
+
+func (d *ProviderInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Information about the Make.com environment and identity the provider is configured " +
+			"against, e.g. to assert that a module is applied to the intended zone and organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Make zone the provider talks to, i.e. the host of `base_url` (e.g. `eu1.make.com`)",
+				Computed:            true,
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Effective base URL of the Make.com API",
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user the API token belongs to",
+				Computed:            true,
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user the API token belongs to",
+				Computed:            true,
+			},
+			"user_email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user the API token belongs to",
+				Computed:            true,
+			},
+			"organizations": schema.ListNestedAttribute{
+				MarkdownDescription: "Organizations the API token has access to",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Organization identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the organization",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes granted to the API token, sorted alphabetically",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *ProviderInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProviderInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_provider_info", "read", nil, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data ProviderInfoDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	user, err := d.client.GetCurrentUser(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read current user, got error: %s", err))
+		return
+	}
+
+	authorization, err := d.client.GetCurrentAuthorization(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token authorization, got error: %s", err))
+		return
+	}
+
+	organizations, err := d.client.ListOrganizations(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organizations, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.Zone = types.StringValue(d.client.Zone())
+	data.BaseUrl = types.StringValue(d.client.BaseURL())
+	data.UserId = types.StringValue(user.ID.String())
+	data.UserName = types.StringValue(user.Name)
+	data.UserEmail = types.StringValue(user.Email)
+	data.Scopes = sortedStringListValue(authorization.Scopes)
+
+	orgValues := make([]attr.Value, 0, len(organizations))
+	for _, org := range organizations {
+		orgValues = append(orgValues, types.ObjectValueMust(providerInfoOrganizationAttrTypes, map[string]attr.Value{
+			"id":   types.StringValue(org.ID.String()),
+			"name": types.StringValue(org.Name),
+		}))
+	}
+	data.Organizations = types.ListValueMust(types.ObjectType{AttrTypes: providerInfoOrganizationAttrTypes}, orgValues)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a provider info data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
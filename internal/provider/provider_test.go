@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"net/http"
+	"os"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -12,11 +15,156 @@ import (
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"make": providerserver.NewProtocol6WithError(New("test")()),
+	"make": providerserver.NewProtocol6WithError(&MakeProvider{
+		version: "test",
+		wrapTransport: func(next http.RoundTripper) http.RoundTripper {
+			return testAccCassette.wrap(next)
+		},
+	}),
+}
+
+// testAccCassette records or replays the API interactions of the running
+// acceptance test, nil when it runs against an API
+var testAccCassette *cassetteTransport
+
+// testAccMockAPIServer returns the mock Make API that acceptance tests run
+// against without MAKE_API_TOKEN, starting it on first use
+var testAccMockAPIServer = sync.OnceValue(newMockMakeAPI)
+
+// testAccMockAPIURL returns the URL of the mock Make API
+func testAccMockAPIURL() string {
+	return testAccMockAPIServer().URL + "/"
+}
+
+// testAccMockAPI returns the mock Make API, e.g. for checks of the objects it
+// stores
+func testAccMockAPI() *mockMakeAPI {
+	return testAccMockAPIServer().Config.Handler.(*mockMakeAPI)
+}
+
+// testAccRecording reports whether acceptance tests record their interactions
+// with the real API into cassettes
+func testAccRecording() bool {
+	return os.Getenv("MAKE_TEST_RECORD") != ""
+}
+
+// testAccReplaying reports whether acceptance tests replay their recorded
+// cassettes instead of contacting an API
+func testAccReplaying() bool {
+	return os.Getenv("MAKE_TEST_REPLAY") != "" && !testAccRecording() && os.Getenv("MAKE_API_TOKEN") == ""
+}
+
+// testAccReplayCassette returns the cassette a test replays, nil when not
+// replaying. A test without a recorded cassette fails when replaying, rather
+// than silently running against the mock API.
+func testAccReplayCassette(t *testing.T) *cassetteTransport {
+	t.Helper()
+
+	if !testAccReplaying() {
+		return nil
+	}
+
+	replay, err := loadCassette(t.Name())
+	if err != nil {
+		t.Fatalf("Unable to load cassette: %s", err)
+	}
+	if replay == nil {
+		t.Fatalf("No cassette recorded for %s in %s, record it with MAKE_TEST_RECORD=1 and MAKE_API_TOKEN set", t.Name(), cassetteDir)
+	}
+
+	return replay
+}
+
+// testAccUseMockAPI reports whether acceptance tests run against the mock
+// Make API, as no credentials for the real one are set and cassettes are not
+// replayed
+func testAccUseMockAPI(t *testing.T) bool {
+	return os.Getenv("MAKE_API_TOKEN") == "" && !testAccRecording() && !testAccReplaying()
+}
+
+// testAccEnv returns a MAKE_TEST_* variable, which tests replaying a cassette
+// take from the recording
+func testAccEnv(t *testing.T, name string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+
+	if replay := testAccReplayCassette(t); replay != nil {
+		return replay.cassette.Env[name]
+	}
+
+	return ""
 }
 
 func testAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
+	switch replay := testAccReplayCassette(t); {
+	case testAccRecording():
+		if os.Getenv("MAKE_API_TOKEN") == "" {
+			t.Fatal("MAKE_API_TOKEN must be set to record acceptance tests")
+		}
+
+		recorder := newCassetteRecorder()
+		testAccCassette = recorder
+		t.Cleanup(func() {
+			testAccCassette = nil
+			if t.Failed() {
+				return
+			}
+			if err := recorder.save(t.Name()); err != nil {
+				t.Errorf("Unable to save cassette: %s", err)
+			}
+		})
+	case os.Getenv("MAKE_API_TOKEN") != "":
+		// Run against the real API
+	case replay != nil:
+		// Recorded interactions are replayed without contacting the API
+		testAccCassette = replay
+		t.Cleanup(func() { testAccCassette = nil })
+
+		t.Setenv("MAKE_API_TOKEN", redactedValue)
+		t.Setenv("MAKE_BASE_URL", replay.cassette.BaseURL)
+		t.Setenv("MAKE_API_PATH_PREFIX", replay.cassette.APIPathPrefix)
+		for name, value := range replay.cassette.Env {
+			t.Setenv(name, value)
+		}
+	default:
+		// Without credentials the provider is pointed at the mock API, so
+		// that the resource lifecycle is tested in CI too
+		t.Setenv("MAKE_API_TOKEN", testMockAPIToken)
+		t.Setenv("MAKE_BASE_URL", testAccMockAPIURL())
+		t.Setenv("MAKE_API_PATH_PREFIX", "")
+	}
+}
+
+// testAccPreCheckLive skips the test unless MAKE_API_TOKEN or
+// MAKE_TEST_REPLAY is set, as it needs endpoints the mock API does not serve
+func testAccPreCheckLive(t *testing.T) {
+	if testAccUseMockAPI(t) {
+		t.Skip("MAKE_API_TOKEN or MAKE_TEST_REPLAY is required for acceptance tests of endpoints the mock API does not serve")
+	}
+
+	testAccPreCheck(t)
+}
+
+// testAccDataStructureID returns the ID of an existing data structure that
+// data stores can be created with, skipping the test when none is configured.
+// The mock API accepts any ID.
+func testAccDataStructureID(t *testing.T) string {
+	dataStructureID := testAccEnv(t, "MAKE_TEST_DATA_STRUCTURE_ID")
+	if dataStructureID == "" && testAccUseMockAPI(t) {
+		return "mock-data-structure"
+	}
+	if dataStructureID == "" {
+		t.Skip("MAKE_TEST_DATA_STRUCTURE_ID must be set to an existing data structure for data store acceptance tests")
+	}
+
+	return dataStructureID
+}
+
+// testAccPreCheckScim skips the test unless the configured organization has
+// SCIM provisioning enabled.
+func testAccPreCheckScim(t *testing.T) {
+	if testAccEnv(t, "MAKE_TEST_SCIM_ENABLED") == "" {
+		t.Skip("MAKE_TEST_SCIM_ENABLED must be set for SCIM acceptance tests")
+	}
 }
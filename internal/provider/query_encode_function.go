@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &QueryEncodeFunction{}
+
+func NewQueryEncodeFunction() function.Function {
+	return &QueryEncodeFunction{}
+}
+
+// QueryEncodeFunction defines the function implementation.
+type QueryEncodeFunction struct{}
+
+func (f *QueryEncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "query_encode"
+}
+
+func (f *QueryEncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Encode a map as a URL query string",
+		MarkdownDescription: "Encodes the given map of strings as a URL query string (`a=1&b=2`). " +
+			"Keys are sorted so the result is stable between runs and never causes spurious diffs.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "values",
+				MarkdownDescription: "Map of query parameter names to values",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *QueryEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &values))
+
+	if resp.Error != nil {
+		return
+	}
+
+	query := make(url.Values, len(values))
+	for k, v := range values {
+		query.Set(k, v)
+	}
+
+	// url.Values.Encode sorts by key
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, query.Encode()))
+}
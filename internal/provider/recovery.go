@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// attributeGetter is satisfied by tfsdk.Plan, tfsdk.State and tfsdk.Config
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+// recoverPanic converts a panic raised while handling a resource or data
+// source operation into an error diagnostic, so that a single malformed API
+// response cannot crash the whole plugin process mid-apply. It must be
+// deferred directly by the operation, e.g.:
+//
+//	defer recoverPanic(ctx, "make_scenario", "read", &req.State, &resp.Diagnostics)
+//
+// The source is used to report the ID of the offending object and may be nil.
+func recoverPanic(ctx context.Context, typeName, operation string, source attributeGetter, diags *diag.Diagnostics) {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+
+	stack := string(debug.Stack())
+
+	address := typeName
+	if source != nil {
+		var id types.String
+		if d := source.GetAttribute(ctx, path.Root("id"), &id); !d.HasError() && !id.IsNull() && !id.IsUnknown() {
+			address = fmt.Sprintf("%s (ID %s)", typeName, id.ValueString())
+		}
+	}
+
+	tflog.Error(ctx, "recovered from panic", map[string]interface{}{
+		"address":   address,
+		"operation": operation,
+		"panic":     fmt.Sprintf("%v", recovered),
+		"stack":     stack,
+	})
+
+	diags.AddError(
+		"Provider Panic",
+		fmt.Sprintf("The provider panicked during %s of %s: %v\n\n"+
+			"This is always a bug in the provider, most likely caused by an unexpected API response. "+
+			"Please report this issue to the provider developers, including the stack trace below.\n\n%s",
+			operation, address, recovered, stack),
+	)
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// referenceIDPattern matches the identifiers Make hands out for teams,
+// organizations, data stores, connections and the like
+var referenceIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// isPlausibleReferenceID reports whether id looks like a Make identifier. It
+// catches typical copy-paste mistakes (names, URLs, stray whitespace) that the
+// API would otherwise only reject during apply.
+func isPlausibleReferenceID(id string) bool {
+	return referenceIDPattern.MatchString(id)
+}
+
+// referenceID returns a validator checking that a string is a plausible Make
+// identifier, for the elements of lists and sets of IDs that referenceFormat
+// does not reach
+func referenceID() validator.String {
+	return stringvalidator.RegexMatches(referenceIDPattern, "must be a Make identifier rather than a name or URL")
+}
+
+// reference describes an attribute holding the ID of another Make object.
+type reference struct {
+	// Path of the attribute holding the ID
+	Path path.Path
+
+	// Endpoint is the format string of the API endpoint used to check that the
+	// referenced object exists, e.g. "v2/teams/%s". Empty to skip the check.
+	Endpoint string
+}
+
+var _ resource.ConfigValidator = referenceFormatValidator{}
+
+// referenceFormatValidator validates that every configured reference holds a
+// plausible Make identifier. Values that are unknown, e.g. because they come
+// from another resource, are checked once known.
+type referenceFormatValidator struct {
+	paths []path.Path
+}
+
+// referenceFormat returns a config validator checking the format of the IDs
+// held by the given attributes.
+func referenceFormat(paths ...path.Path) resource.ConfigValidator {
+	return referenceFormatValidator{paths: paths}
+}
+
+func (v referenceFormatValidator) Description(ctx context.Context) string {
+	return "referenced IDs must be plausible Make identifiers"
+}
+
+func (v referenceFormatValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v referenceFormatValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	for _, p := range v.paths {
+		var id types.String
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &id)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if id.IsNull() || id.IsUnknown() || isPlausibleReferenceID(id.ValueString()) {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			p,
+			"Invalid Reference",
+			fmt.Sprintf("The value %q is not a valid Make identifier. Identifiers only contain letters, digits, "+
+				"dashes and underscores; make sure an ID rather than a name or URL was given.", id.ValueString()),
+		)
+	}
+}
+
+// CheckReferences checks that the objects referenced by the planned values
+// exist in Make, turning errors the API would raise during apply into plan-time
+// errors. It is a no-op unless the provider was configured with
+// validate_references, as every reference costs an additional API call.
+func (c *apiClient) CheckReferences(ctx context.Context, plan attributeGetter, diags *diag.Diagnostics, refs ...reference) {
+	if c == nil || !c.ValidateReferences {
+		return
+	}
+
+	// Check the references with the credentials that will manage the object
+	var profile types.String
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("auth_profile"), &profile)...)
+
+	if diags.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, profile)
+
+	for _, ref := range refs {
+		if ref.Endpoint == "" {
+			continue
+		}
+
+		var id types.String
+
+		diags.Append(plan.GetAttribute(ctx, ref.Path, &id)...)
+
+		if diags.HasError() {
+			return
+		}
+
+		// Unknown values reference objects created in the same apply
+		if id.IsNull() || id.IsUnknown() || !isPlausibleReferenceID(id.ValueString()) {
+			continue
+		}
+
+		exists, err := c.ReferenceExists(ctx, fmt.Sprintf(ref.Endpoint, id.ValueString()))
+		if err != nil {
+			diags.AddAttributeWarning(
+				ref.Path,
+				"Unable to Validate Reference",
+				fmt.Sprintf("Could not check that %q exists, got error: %s", id.ValueString(), err),
+			)
+			continue
+		}
+
+		if !exists {
+			diags.AddAttributeError(
+				ref.Path,
+				"Referenced Object Not Found",
+				fmt.Sprintf("No object with ID %q exists in Make, or the API token has no access to it.", id.ValueString()),
+			)
+		}
+	}
+}
@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccScenarioResource(t *testing.T) {
@@ -18,6 +25,7 @@ func TestAccScenarioResource(t *testing.T) {
 					resource.TestCheckResourceAttr("make_scenario.test", "name", "Test Scenario example"),
 					resource.TestCheckResourceAttr("make_scenario.test", "description", "Test scenario description"),
 					resource.TestCheckResourceAttr("make_scenario.test", "active", "true"),
+					resource.TestCheckResourceAttr("make_scenario.test", "force_stop_on_destroy", "true"),
 					resource.TestCheckResourceAttrSet("make_scenario.test", "id"),
 				),
 			},
@@ -48,6 +56,79 @@ resource "make_scenario" "test" {
 `
 }
 
+func TestAccScenarioResourceExecutions(t *testing.T) {
+	dataStructureID := testAccDataStructureID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScenarioResourceExecutionsConfig(dataStructureID, `
+  execution_retention_days = 30
+  export_executions_to = {
+    type = "data_store"
+    id   = make_data_store.first.id
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_scenario.test", "execution_retention_days", "30"),
+					resource.TestCheckResourceAttr("make_scenario.test", "export_executions_to.type", "data_store"),
+					resource.TestCheckResourceAttrPair("make_scenario.test", "export_executions_to.id", "make_data_store.first", "id"),
+				),
+			},
+			{
+				Config: testAccScenarioResourceExecutionsConfig(dataStructureID, `
+  execution_retention_days = 60
+  export_executions_to = {
+    type = "data_store"
+    id   = make_data_store.second.id
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_scenario.test", "execution_retention_days", "60"),
+					resource.TestCheckResourceAttrPair("make_scenario.test", "export_executions_to.id", "make_data_store.second", "id"),
+				),
+			},
+			// Removing them stops the export but keeps the retention in Make
+			{
+				Config: testAccScenarioResourceExecutionsConfig(dataStructureID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_scenario.test", "execution_retention_days", "60"),
+					resource.TestCheckNoResourceAttr("make_scenario.test", "export_executions_to.type"),
+					resource.TestCheckNoResourceAttr("make_scenario.test", "export_executions_to.id"),
+				),
+			},
+			{
+				Config: testAccScenarioResourceExecutionsConfig(dataStructureID, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccScenarioResourceExecutionsConfig(dataStructureID, executions string) string {
+	return `
+resource "make_data_store" "first" {
+  name              = "Test Scenario Executions first"
+  data_structure_id = "` + dataStructureID + `"
+}
+
+resource "make_data_store" "second" {
+  name              = "Test Scenario Executions second"
+  data_structure_id = "` + dataStructureID + `"
+}
+
+resource "make_scenario" "test" {
+  name   = "Test Scenario executions"
+  active = false
+` + executions + `
+}
+`
+}
+
 func TestAccConnectionResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -86,6 +167,146 @@ resource "make_connection" "test" {
 `
 }
 
+func TestAccConnectionResourceParameters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Parameters are checked against the apps catalog during plan
+			{
+				Config:      testAccConnectionResourceParametersConfig(`{ hots = "db.example.com" }`),
+				ExpectError: regexp.MustCompile(`Unknown Connection Parameter`),
+			},
+			{
+				Config:      testAccConnectionResourceParametersConfig(`{ host = "db.example.com", port = "default" }`),
+				ExpectError: regexp.MustCompile(`Invalid Connection Parameter`),
+			},
+			// Parameters keep their types
+			{
+				Config: testAccConnectionResourceParametersConfig(`{ host = "db.example.com", port = 5432, ssl = true }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_connection.test", "parameters.host", "db.example.com"),
+					resource.TestCheckResourceAttr("make_connection.test", "parameters.port", "5432"),
+					resource.TestCheckResourceAttr("make_connection.test", "parameters.ssl", "true"),
+				),
+			},
+			// A refresh without changes leaves an empty plan
+			{
+				Config:   testAccConnectionResourceParametersConfig(`{ host = "db.example.com", port = 5432, ssl = true }`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccConnectionResourceParametersConfig(parameters string) string {
+	return `
+resource "make_connection" "test" {
+  name       = "Test Database"
+  app_name   = "postgres"
+  parameters = ` + parameters + `
+}
+`
+}
+
+func TestAccConnectionResourceTeamChange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionResourceTeamConfig("a"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("make_connection.test", "team_id", "make_team.a", "id"),
+				),
+			},
+			// Make cannot move a connection to another team
+			{
+				Config: testAccConnectionResourceTeamConfig("b"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("make_connection.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("make_connection.test", "team_id", "make_team.b", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConnectionResourceTeamConfig(team string) string {
+	return `
+resource "make_team" "a" {
+  name = "Test Connection Team A"
+}
+
+resource "make_team" "b" {
+  name = "Test Connection Team B"
+}
+
+resource "make_connection" "test" {
+  name     = "Test Connection Team"
+  app_name = "gmail"
+  team_id  = make_team.` + team + `.id
+}
+`
+}
+
+func TestAccConnectionBatchResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccConnectionBatchResourceConfig([]string{"a", "b"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_connection_batch.test", "app_name", "gmail"),
+					resource.TestCheckResourceAttr("make_connection_batch.test", "connections.%", "2"),
+					resource.TestCheckResourceAttr("make_connection_batch.test", "connections.a.name", "Test Connection a"),
+					resource.TestCheckResourceAttrSet("make_connection_batch.test", "connections.a.connection_id"),
+					resource.TestCheckResourceAttrSet("make_connection_batch.test", "connections.b.verified"),
+					resource.TestCheckResourceAttrSet("make_connection_batch.test", "id"),
+				),
+			},
+			// Update testing, removing and adding items
+			{
+				Config: testAccConnectionBatchResourceConfig([]string{"b", "c"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_connection_batch.test", "connections.%", "2"),
+					resource.TestCheckNoResourceAttr("make_connection_batch.test", "connections.a.name"),
+					resource.TestCheckResourceAttrSet("make_connection_batch.test", "connections.c.connection_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConnectionBatchResourceConfig(keys []string) string {
+	connections := ""
+	for _, key := range keys {
+		connections += `
+    ` + key + ` = {
+      name     = "Test Connection ` + key + `"
+      settings = { api_key = "dummy-` + key + `" }
+    }`
+	}
+
+	return `
+resource "make_connection_batch" "test" {
+  app_name = "gmail"
+  settings = {
+    scope = "readonly"
+  }
+
+  connections = {` + connections + `
+  }
+}
+`
+}
+
 func TestAccWebhookResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -97,11 +318,25 @@ func TestAccWebhookResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("make_webhook.test", "name", "Test Webhook example"),
 					resource.TestCheckResourceAttr("make_webhook.test", "active", "true"),
-					resource.TestCheckResourceAttr("make_webhook.test", "settings.secret", "s3cr3t"),
+					resource.TestCheckResourceAttr("make_webhook.test", "settings.headers", "true"),
+					resource.TestCheckResourceAttr("make_webhook.test", "settings.stringify", "false"),
+					resource.TestCheckResourceAttr("make_webhook.test", "type", "gateway-webhook"),
 					resource.TestCheckResourceAttrSet("make_webhook.test", "id"),
 					resource.TestCheckResourceAttrSet("make_webhook.test", "url"),
 				),
 			},
+			// Update keeps the existing URL known during plan
+			{
+				Config: testAccWebhookResourceConfig("renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("make_webhook.test", tfjsonpath.New("url"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_webhook.test", "name", "Test Webhook renamed"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "make_webhook.test",
@@ -118,115 +353,1232 @@ resource "make_webhook" "test" {
   name   = "Test Webhook ` + suffix + `"
   active = true
   settings = {
-    secret = "s3cr3t"
+    headers = true
   }
 }
 `
 }
 
-func TestAccTeamResource(t *testing.T) {
+func TestAccWebhookResourceResponse(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTeamResourceConfig("example"),
+				Config: testAccWebhookResourceResponseConfig(`
+  response = {
+    status_code = 202
+    body        = jsonencode({ accepted = true })
+    headers = {
+      "Content-Type" = "application/json"
+    }
+  }`),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("make_team.test", "name", "Test Team example"),
-					resource.TestCheckResourceAttrSet("make_team.test", "id"),
+					resource.TestCheckResourceAttr("make_webhook.test", "response.status_code", "202"),
+					resource.TestCheckResourceAttr("make_webhook.test", "response.body", `{"accepted":true}`),
+					resource.TestCheckResourceAttr("make_webhook.test", "response.headers.Content-Type", "application/json"),
+					testAccCheckMockField(t, "make_webhook.test", "v2/webhooks", "response",
+						`{"status_code": 202, "body": "{\"accepted\":true}", "headers": {"Content-Type": "application/json"}}`),
 				),
 			},
 			{
-				ResourceName:      "make_team.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: testAccWebhookResourceResponseConfig(`
+  response = {
+    status_code = 201
+    body        = "Created"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_webhook.test", "response.status_code", "201"),
+					resource.TestCheckResourceAttr("make_webhook.test", "response.body", "Created"),
+					testAccCheckMockField(t, "make_webhook.test", "v2/webhooks", "response", `{"status_code": 201, "body": "Created"}`),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("make_webhook.test", tfjsonpath.New("response").AtMapKey("headers"), knownvalue.Null()),
+				},
 			},
+			// Removing the response restores the default reply
 			{
-				Config: testAccTeamResourceConfig("updated"),
+				Config: testAccWebhookResourceResponseConfig(""),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("make_team.test", "name", "Test Team updated"),
+					testAccCheckMockField(t, "make_webhook.test", "v2/webhooks", "response", "null"),
 				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("make_webhook.test", tfjsonpath.New("response"), knownvalue.Null()),
+				},
 			},
 		},
 	})
 }
 
-func testAccTeamResourceConfig(suffix string) string {
+func testAccWebhookResourceResponseConfig(response string) string {
 	return `
-resource "make_team" "test" {
-  name = "Test Team ` + suffix + `"
+resource "make_webhook" "test" {
+  name   = "Test Webhook response"
+  active = true
+` + response + `
 }
 `
 }
 
-func TestAccOrganizationResource(t *testing.T) {
+func TestAccWebhookResourceActive(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccOrganizationResourceConfig("example"),
+				Config: testAccWebhookResourceActiveConfig("true"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("make_organization.test", "name", "Test Organization example"),
-					resource.TestCheckResourceAttrSet("make_organization.test", "id"),
+					resource.TestCheckResourceAttr("make_webhook.test", "active", "true"),
+					testAccCheckMockRequests(t, "make_webhook.test", "v2/webhooks"),
 				),
 			},
+			// Changing only active goes through the disable and enable
+			// endpoints instead of updating the webhook
 			{
-				ResourceName:      "make_organization.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: testAccWebhookResourceActiveConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_webhook.test", "active", "false"),
+					testAccCheckMockRequests(t, "make_webhook.test", "v2/webhooks", "POST v2/webhooks/%s/disable"),
+					testAccCheckMockField(t, "make_webhook.test", "v2/webhooks", "active", "false"),
+				),
 			},
 			{
-				Config: testAccOrganizationResourceConfig("updated"),
+				Config: testAccWebhookResourceActiveConfig("true"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("make_organization.test", "name", "Test Organization updated"),
+					resource.TestCheckResourceAttr("make_webhook.test", "active", "true"),
+					testAccCheckMockRequests(t, "make_webhook.test", "v2/webhooks", "POST v2/webhooks/%s/enable"),
+					testAccCheckMockField(t, "make_webhook.test", "v2/webhooks", "active", "true"),
 				),
 			},
 		},
 	})
 }
 
-func testAccOrganizationResourceConfig(suffix string) string {
+func testAccWebhookResourceActiveConfig(active string) string {
 	return `
-resource "make_organization" "test" {
-  name = "Test Organization ` + suffix + `"
+resource "make_webhook" "test" {
+  name   = "Test Webhook active"
+  active = ` + active + `
+  settings = {
+    headers = true
+  }
 }
 `
 }
 
-func TestAccDataStoreResource(t *testing.T) {
+func TestAccWebhookResourceRotateURL(t *testing.T) {
+	url := statecheck.CompareValue(compare.ValuesDiffer())
+	adoptedURL := statecheck.CompareValue(compare.ValuesSame())
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDataStoreResourceConfig("example"),
+				Config: testAccWebhookResourceRotateURLConfig(""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					url.AddStateValue("make_webhook.test", tfjsonpath.New("url")),
+					adoptedURL.AddStateValue("make_webhook.test", tfjsonpath.New("url")),
+				},
+			},
+			// Setting rotate_url for the first time keeps the URL
+			{
+				Config: testAccWebhookResourceRotateURLConfig("2026-01"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("make_webhook.test", tfjsonpath.New("url"), knownvalue.NotNull()),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					adoptedURL.AddStateValue("make_webhook.test", tfjsonpath.New("url")),
+				},
+			},
+			// Changing rotate_url leaves the URL unknown until the apply
+			// stores the new one
+			{
+				Config: testAccWebhookResourceRotateURLConfig("2026-02"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("make_webhook.test", tfjsonpath.New("url")),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("make_data_store.test", "name", "Test Data Store example"),
-					resource.TestCheckResourceAttr("make_data_store.test", "description", "Test data store description"),
-					resource.TestCheckResourceAttrSet("make_data_store.test", "id"),
+					resource.TestCheckResourceAttrSet("make_webhook.test", "url"),
+					testAccCheckMockRequests(t, "make_webhook.test", "v2/webhooks", "POST v2/webhooks/%s/rotate-url"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					url.AddStateValue("make_webhook.test", tfjsonpath.New("url")),
+				},
+			},
+		},
+	})
+}
+
+func testAccWebhookResourceRotateURLConfig(rotateURL string) string {
+	if rotateURL == "" {
+		return `
+resource "make_webhook" "test" {
+  name   = "Test Webhook rotate"
+  active = true
+}
+`
+	}
+
+	return `
+resource "make_webhook" "test" {
+  name       = "Test Webhook rotate"
+  active     = true
+  rotate_url = "` + rotateURL + `"
+}
+`
+}
+
+func TestAccWebhookResourceLearningMode(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookResourceLearningModeConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_webhook.test", "learning_mode", "true"),
+					testAccCheckMockRequests(t, "make_webhook.test", "v2/webhooks", "POST v2/webhooks/%s/learn-start"),
+					testAccCheckMockField(t, "make_webhook.test", "v2/webhooks", "learning", "true"),
 				),
 			},
 			{
-				ResourceName:      "make_data_store.test",
+				Config: testAccWebhookResourceLearningModeConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_webhook.test", "learning_mode", "false"),
+					testAccCheckMockRequests(t, "make_webhook.test", "v2/webhooks", "POST v2/webhooks/%s/learn-stop"),
+					testAccCheckMockField(t, "make_webhook.test", "v2/webhooks", "learning", "false"),
+				),
+			},
+			{
+				Config: testAccWebhookResourceLearningModeConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_webhook.test", "learning_mode", "true"),
+					testAccCheckMockRequests(t, "make_webhook.test", "v2/webhooks", "POST v2/webhooks/%s/learn-start"),
+					testAccCheckMockField(t, "make_webhook.test", "v2/webhooks", "learning", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhookResourceLearningModeConfig(learningMode string) string {
+	return `
+resource "make_webhook" "test" {
+  name          = "Test Webhook learning"
+  active        = true
+  learning_mode = ` + learningMode + `
+}
+`
+}
+
+func TestAccTeamResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamResourceConfig("example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_team.test", "name", "Test Team example"),
+					resource.TestCheckResourceAttrSet("make_team.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_team.test",
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccDataStoreResourceConfig("updated"),
+				Config: testAccTeamResourceConfig("updated"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("make_data_store.test", "name", "Test Data Store updated"),
+					resource.TestCheckResourceAttr("make_team.test", "name", "Test Team updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTeamResourceIdentity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Resource identity requires Terraform 1.12
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamResourceConfig("identity"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("make_team.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    "make_team.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccTeamResourceTimeouts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamResourceTimeoutsConfig("10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_team.test", "timeouts.create", "10m"),
+					resource.TestCheckResourceAttrSet("make_team.test", "id"),
 				),
 			},
+			// Invalid durations are rejected before any API call
+			{
+				Config:      testAccTeamResourceTimeoutsConfig("ten minutes"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Time Duration"),
+			},
 		},
 	})
 }
 
-func testAccDataStoreResourceConfig(suffix string) string {
+func testAccTeamResourceTimeoutsConfig(create string) string {
 	return `
-resource "make_data_store" "test" {
-  name        = "Test Data Store ` + suffix + `"
-  description = "Test data store description"
+resource "make_team" "test" {
+  name = "Test Team Timeouts"
+
+  timeouts {
+    create = "` + create + `"
+    delete = "2m"
+  }
+}
+`
+}
+
+func testAccTeamResourceConfig(suffix string) string {
+	return `
+resource "make_team" "test" {
+  name = "Test Team ` + suffix + `"
+}
+`
+}
+
+func TestAccTeamResourceLimits(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamResourceLimitsConfig("10000"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_team.test", "operations_limit", "10000"),
+					resource.TestCheckResourceAttr("make_team.test", "data_transfer_limit_mb", "512"),
+				),
+			},
+			{
+				ResourceName:      "make_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTeamResourceLimitsConfig("20000"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_team.test", "operations_limit", "20000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamResourceLimitsConfig(operationsLimit string) string {
+	return `
+resource "make_team" "test" {
+  name                   = "Test Team Limits"
+  operations_limit       = ` + operationsLimit + `
+  data_transfer_limit_mb = 512
+}
+`
+}
+
+func TestAccOrganizationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationResourceConfig("example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_organization.test", "name", "Test Organization example"),
+					resource.TestCheckResourceAttrSet("make_organization.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_organization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationResourceConfig("updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_organization.test", "name", "Test Organization updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationResourceConfig(suffix string) string {
+	return `
+resource "make_organization" "test" {
+  name = "Test Organization ` + suffix + `"
+}
+`
+}
+
+func TestAccDataStoreResource(t *testing.T) {
+	dataStructureID := testAccDataStructureID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataStoreResourceConfig(dataStructureID, "example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_data_store.test", "name", "Test Data Store example"),
+					resource.TestCheckResourceAttr("make_data_store.test", "description", "Test data store description"),
+					resource.TestCheckResourceAttr("make_data_store.test", "data_structure_id", dataStructureID),
+					resource.TestCheckResourceAttr("make_data_store.test", "max_size_mb", "1"),
+					resource.TestCheckResourceAttr("make_data_store.test", "strict", "false"),
+					resource.TestCheckResourceAttr("make_data_store.test", "record_count", "0"),
+					resource.TestCheckResourceAttrSet("make_data_store.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_data_store.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataStoreResourceConfig(dataStructureID, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_data_store.test", "name", "Test Data Store updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataStoreResourceConfig(dataStructureID, suffix string) string {
+	return `
+resource "make_data_store" "test" {
+  name              = "Test Data Store ` + suffix + `"
+  description       = "Test data store description"
+  data_structure_id = "` + dataStructureID + `"
+}
+`
+}
+
+func TestAccDataStoreRecordsResource(t *testing.T) {
+	dataStructureID := testAccDataStructureID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataStoreRecordsResourceConfig(dataStructureID, `
+    alice = jsonencode({ name = "Alice" })
+    bob   = jsonencode({ name = "Bob" })`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_data_store_records.test", "records.%", "2"),
+					resource.TestCheckResourceAttr("make_data_store_records.test", "ignore_drift", "false"),
+					resource.TestCheckResourceAttrPair("make_data_store_records.test", "id", "make_data_store.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_data_store_records.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataStoreRecordsResourceConfig(dataStructureID, `
+    alice = jsonencode({ name = "Alice Smith" })
+    carol = jsonencode({ name = "Carol" })`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_data_store_records.test", "records.%", "2"),
+					resource.TestCheckResourceAttr("make_data_store_records.test", "records.alice", `{"name":"Alice Smith"}`),
+					resource.TestCheckNoResourceAttr("make_data_store_records.test", "records.bob"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataStoreRecordsResourceConfig(dataStructureID, records string) string {
+	return `
+resource "make_data_store" "test" {
+  name              = "Test Data Store Records"
+  data_structure_id = "` + dataStructureID + `"
+}
+
+resource "make_data_store_records" "test" {
+  data_store_id = make_data_store.test.id
+
+  records = {` + records + `
+  }
+}
+`
+}
+
+func TestAccTeamMemberResource(t *testing.T) {
+	userID := testAccEnv(t, "MAKE_TEST_USER_ID")
+	if userID == "" {
+		t.Skip("MAKE_TEST_USER_ID must be set to an existing user for team membership acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMemberResourceConfig(userID, "team_member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_team_member.test", "user_id", userID),
+					resource.TestCheckResourceAttr("make_team_member.test", "role", "team_member"),
+					resource.TestCheckResourceAttrSet("make_team_member.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_team_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTeamMemberResourceConfig(userID, "team_admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_team_member.test", "role", "team_admin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamMemberResourceConfig(userID, role string) string {
+	return `
+resource "make_team" "test" {
+  name = "Test Team Members"
+}
+
+resource "make_team_member" "test" {
+  team_id = make_team.test.id
+  user_id = "` + userID + `"
+  role    = "` + role + `"
+}
+`
+}
+
+func TestAccOrganizationMemberResource(t *testing.T) {
+	userID := testAccEnv(t, "MAKE_TEST_USER_ID")
+	if userID == "" {
+		t.Skip("MAKE_TEST_USER_ID must be set to an existing user for organization membership acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationMemberResourceConfig(userID, "member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_organization_member.test", "user_id", userID),
+					resource.TestCheckResourceAttr("make_organization_member.test", "role", "member"),
+					resource.TestCheckResourceAttrSet("make_organization_member.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_organization_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationMemberResourceConfig(userID, "admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_organization_member.test", "role", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationMemberResourceConfig(userID, role string) string {
+	return `
+resource "make_organization" "test" {
+  name = "Test Organization Members"
+}
+
+resource "make_organization_member" "test" {
+  organization_id = make_organization.test.id
+  user_id         = "` + userID + `"
+  role            = "` + role + `"
+}
+`
+}
+
+func TestAccOrganizationInviteResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationInviteResourceConfig("invitee@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_organization_invite.test", "email", "invitee@example.com"),
+					resource.TestCheckResourceAttr("make_organization_invite.test", "role", "member"),
+					resource.TestCheckResourceAttr("make_organization_invite.test", "team_ids.#", "1"),
+					resource.TestCheckResourceAttr("make_organization_invite.test", "status", "pending"),
+					resource.TestCheckResourceAttrSet("make_organization_invite.test", "id"),
+				),
+			},
+			// Changing the email sends a new invitation
+			{
+				Config: testAccOrganizationInviteResourceConfig("other-invitee@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_organization_invite.test", "email", "other-invitee@example.com"),
+					resource.TestCheckResourceAttr("make_organization_invite.test", "status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationInviteResourceConfig(email string) string {
+	return `
+resource "make_organization" "test" {
+  name = "Test Organization Invites"
+}
+
+resource "make_team" "test" {
+  name            = "Test Team Invites"
+  organization_id = make_organization.test.id
+}
+
+resource "make_organization_invite" "test" {
+  organization_id = make_organization.test.id
+  email           = "` + email + `"
+  role            = "member"
+  team_ids        = [make_team.test.id]
+}
+`
+}
+
+func TestAccOrganizationSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationSettingsResourceConfig("Europe/Prague"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_organization_settings.test", "timezone", "Europe/Prague"),
+					resource.TestCheckResourceAttr("make_organization_settings.test", "country_code", "CZ"),
+					resource.TestCheckResourceAttrPair("make_organization_settings.test", "id", "make_organization.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_organization_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationSettingsResourceConfig("Europe/London"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_organization_settings.test", "timezone", "Europe/London"),
+					resource.TestCheckResourceAttr("make_organization_settings.test", "country_code", "CZ"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationSettingsResourceConfig(timezone string) string {
+	return `
+resource "make_organization" "test" {
+  name = "Test Organization Settings"
+}
+
+resource "make_organization_settings" "test" {
+  organization_id = make_organization.test.id
+  timezone        = "` + timezone + `"
+  country_code    = "CZ"
+}
+`
+}
+
+func TestAccOrganizationIPAllowlistResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The catch-all ranges keep the test runner able to reach the organization
+			{
+				Config: testAccOrganizationIPAllowlistResourceConfig(`"0.0.0.0/0", "::/0"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_organization_ip_allowlist.test", "ip_ranges.#", "2"),
+					resource.TestCheckTypeSetElemAttr("make_organization_ip_allowlist.test", "ip_ranges.*", "0.0.0.0/0"),
+					resource.TestCheckResourceAttrPair("make_organization_ip_allowlist.test", "id", "make_organization.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_organization_ip_allowlist.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationIPAllowlistResourceConfig(`"0.0.0.0/0", "::/0", "203.0.113.10"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_organization_ip_allowlist.test", "ip_ranges.#", "3"),
+					resource.TestCheckTypeSetElemAttr("make_organization_ip_allowlist.test", "ip_ranges.*", "203.0.113.10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationIPAllowlistResourceConfig(ranges string) string {
+	return `
+resource "make_organization" "test" {
+  name = "Test Organization IP Allowlist"
+}
+
+resource "make_organization_ip_allowlist" "test" {
+  organization_id = make_organization.test.id
+  ip_ranges       = [` + ranges + `]
+}
+`
+}
+
+func TestAccAuditLogExportResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditLogExportResourceConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_audit_log_export.test", "destination_type", "webhook"),
+					resource.TestCheckResourceAttr("make_audit_log_export.test", "url", "https://siem.example.com/make/audit"),
+					resource.TestCheckResourceAttr("make_audit_log_export.test", "enabled", "true"),
+					resource.TestCheckResourceAttrPair("make_audit_log_export.test", "id", "make_organization.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_audit_log_export.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The headers are never returned by the API
+				ImportStateVerifyIgnore: []string{"headers"},
+			},
+			{
+				Config: testAccAuditLogExportResourceConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_audit_log_export.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuditLogExportResourceConfig(enabled string) string {
+	return `
+resource "make_organization" "test" {
+  name = "Test Organization Audit Log Export"
+}
+
+resource "make_audit_log_export" "test" {
+  organization_id  = make_organization.test.id
+  destination_type = "webhook"
+  url              = "https://siem.example.com/make/audit"
+  enabled          = ` + enabled + `
+
+  headers = {
+    Authorization = "Bearer test"
+  }
+}
+`
+}
+
+func TestAccNotificationPreferencesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationPreferencesResourceConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_notification_preferences.test", "scenario_errors", "true"),
+					resource.TestCheckResourceAttr("make_notification_preferences.test", "scenario_warnings", "false"),
+					resource.TestCheckResourceAttr("make_notification_preferences.test", "frequency", "daily"),
+					resource.TestCheckResourceAttrPair("make_notification_preferences.test", "id", "make_team.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_notification_preferences.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNotificationPreferencesResourceConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_notification_preferences.test", "scenario_warnings", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationPreferencesResourceConfig(warnings string) string {
+	return `
+resource "make_team" "test" {
+  name = "Test Team Notifications"
+}
+
+resource "make_notification_preferences" "test" {
+  team_id           = make_team.test.id
+  scenario_errors   = true
+  scenario_warnings = ` + warnings + `
+  frequency         = "daily"
+}
+`
+}
+
+func TestAccTeamVariableResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamVariableResourceConfig("42"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_team_variable.test", "name", "tf_acc_threshold"),
+					resource.TestCheckResourceAttr("make_team_variable.test", "type", "number"),
+					resource.TestCheckResourceAttr("make_team_variable.test", "value", "42"),
+					resource.TestCheckResourceAttr("make_team_variable.test", "is_secret", "false"),
+					resource.TestCheckResourceAttrSet("make_team_variable.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_team_variable.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTeamVariableResourceConfig("43.5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_team_variable.test", "value", "43.5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamVariableResourceConfig(value string) string {
+	return `
+resource "make_team" "test" {
+  name = "Test Team Variables"
+}
+
+resource "make_team_variable" "test" {
+  team_id = make_team.test.id
+  name    = "tf_acc_threshold"
+  type    = "number"
+  value   = "` + value + `"
+}
+`
+}
+
+func TestAccTeamVariableResourceDefaultTeam(t *testing.T) {
+	teamID := testAccEnv(t, "MAKE_TEST_TEAM_ID")
+	if teamID == "" {
+		t.Skip("MAKE_TEST_TEAM_ID must be set to an existing team for provider default acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "make" {
+  default_team_id = "` + teamID + `"
+}
+
+resource "make_team_variable" "test" {
+  name  = "tf_acc_default_team"
+  type  = "string"
+  value = "default"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_team_variable.test", "team_id", teamID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomFunctionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFunctionResourceConfig("100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_custom_function.test", "name", "tfAccToCents"),
+					resource.TestCheckResourceAttr("make_custom_function.test", "description", "Converts an amount to cents"),
+					resource.TestCheckResourceAttrSet("make_custom_function.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_custom_function.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCustomFunctionResourceConfig("1000"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_custom_function.test", "code", "function tfAccToCents(amount) { return Math.round(amount * 1000); }"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomFunctionResourceConfig(factor string) string {
+	return `
+resource "make_team" "test" {
+  name = "Test Team Functions"
+}
+
+resource "make_custom_function" "test" {
+  team_id     = make_team.test.id
+  name        = "tfAccToCents"
+  description = "Converts an amount to cents"
+  code        = "function tfAccToCents(amount) { return Math.round(amount * ` + factor + `); }"
+}
+`
+}
+
+func TestAccKeyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		// Write-only attributes require Terraform 1.11
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyResourceConfig("Test Key", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_key.test", "name", "Test Key"),
+					resource.TestCheckResourceAttr("make_key.test", "type", "aes-key"),
+					resource.TestCheckNoResourceAttr("make_key.test", "parameters_wo"),
+					resource.TestCheckResourceAttrSet("make_key.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "make_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters_wo_version"},
+			},
+			// Bumping the version rotates the secret in place
+			{
+				Config: testAccKeyResourceConfig("Test Key Rotated", "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("make_key.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_key.test", "name", "Test Key Rotated"),
+					resource.TestCheckResourceAttr("make_key.test", "parameters_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyResourceConfig(name, version string) string {
+	return `
+resource "make_team" "test" {
+  name = "Test Team Keys"
+}
+
+resource "make_key" "test" {
+  team_id = make_team.test.id
+  name    = "` + name + `"
+  type    = "aes-key"
+
+  parameters_wo = {
+    key = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+  }
+  parameters_wo_version = ` + version + `
+}
+`
+}
+
+func TestAccScimUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckLive(t)
+			testAccPreCheckScim(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScimUserResourceConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_scim_user.test", "user_name", "tf-acc-scim@example.com"),
+					resource.TestCheckResourceAttr("make_scim_user.test", "email", "tf-acc-scim@example.com"),
+					resource.TestCheckResourceAttr("make_scim_user.test", "active", "true"),
+					resource.TestCheckResourceAttrSet("make_scim_user.test", "display_name"),
+					resource.TestCheckResourceAttrSet("make_scim_user.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_scim_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Deactivation keeps the user
+			{
+				Config: testAccScimUserResourceConfig("false"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("make_scim_user.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_scim_user.test", "active", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScimUserResourceConfig(active string) string {
+	return `
+resource "make_scim_user" "test" {
+  user_name   = "tf-acc-scim@example.com"
+  external_id = "tf-acc-scim"
+  given_name  = "Terraform"
+  family_name = "Acceptance"
+  active      = ` + active + `
+}
+`
+}
+
+func TestAccScimGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckLive(t)
+			testAccPreCheckScim(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScimGroupResourceConfig("[make_scim_user.first.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_scim_group.test", "display_name", "tf-acc-scim-group"),
+					resource.TestCheckResourceAttr("make_scim_group.test", "team_role", "team_member"),
+					resource.TestCheckResourceAttr("make_scim_group.test", "members.#", "1"),
+					resource.TestCheckResourceAttrPair("make_scim_group.test", "team_id", "make_team.test", "id"),
+					resource.TestCheckResourceAttrSet("make_scim_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "make_scim_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The team role is only tracked when configured
+				ImportStateVerifyIgnore: []string{"team_role"},
+			},
+			{
+				Config: testAccScimGroupResourceConfig("[make_scim_user.first.id, make_scim_user.second.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_scim_group.test", "members.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScimGroupResourceConfig(members string) string {
+	return `
+resource "make_team" "test" {
+  name = "Test Team SCIM"
+}
+
+resource "make_scim_user" "first" {
+  user_name = "tf-acc-scim-first@example.com"
+}
+
+resource "make_scim_user" "second" {
+  user_name = "tf-acc-scim-second@example.com"
+}
+
+resource "make_scim_group" "test" {
+  display_name = "tf-acc-scim-group"
+  team_id      = make_team.test.id
+  team_role    = "team_member"
+  members      = ` + members + `
+}
+`
+}
+
+func TestAccAPITokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPITokenResourceConfig("2026-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_api_token.test", "label", "Test API Token"),
+					resource.TestCheckResourceAttr("make_api_token.test", "scopes.#", "1"),
+					resource.TestCheckResourceAttrSet("make_api_token.test", "token"),
+					resource.TestCheckResourceAttrSet("make_api_token.test", "id"),
+				),
+			},
+			// Changing a keeper rotates the token
+			{
+				Config: testAccAPITokenResourceConfig("2026-02"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("make_api_token.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("make_api_token.test", "token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPITokenResourceConfig(rotation string) string {
+	return `
+resource "make_api_token" "test" {
+  label  = "Test API Token"
+  scopes = ["scenarios:read"]
+
+  keepers = {
+    rotation = "` + rotation + `"
+  }
+}
+`
+}
+
+func TestAccCustomAppResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomAppResourceConfig("Test App"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_custom_app.test", "name", "tf-acc-test-app"),
+					resource.TestCheckResourceAttr("make_custom_app.test", "version", "1"),
+					resource.TestCheckResourceAttr("make_custom_app.test", "label", "Test App"),
+					resource.TestCheckResourceAttr("make_custom_app.test", "theme", "#6e2bc5"),
+					resource.TestCheckResourceAttr("make_custom_app.test", "id", "tf-acc-test-app/1"),
+					resource.TestCheckResourceAttrSet("make_custom_app.test", "base"),
+					resource.TestCheckResourceAttr("make_custom_app.test", "published", "false"),
+				),
+			},
+			{
+				ResourceName:            "make_custom_app.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"base", "groups"},
+			},
+			{
+				Config: testAccCustomAppResourceConfig("Test App Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_custom_app.test", "label", "Test App Updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomAppResourceConfig(label string) string {
+	return `
+resource "make_custom_app" "test" {
+  name        = "tf-acc-test-app"
+  label       = "` + label + `"
+  description = "Test custom app"
+  theme       = "#6e2bc5"
+
+  base = jsonencode({
+    baseUrl = "https://api.example.com"
+    headers = { "x-client" = "terraform" }
+  })
+  groups = jsonencode([
+    { label = "Records", modules = [] }
+  ])
+}
+`
+}
+
+func TestAccCustomAppInviteResource(t *testing.T) {
+	organizationID := testAccEnv(t, "MAKE_TEST_INVITED_ORGANIZATION_ID")
+	if organizationID == "" {
+		t.Skip("MAKE_TEST_INVITED_ORGANIZATION_ID must be set to another organization for custom app invite acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckLive(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomAppInviteResourceConfig(organizationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("make_custom_app.test", "published", "true"),
+					resource.TestCheckResourceAttr("make_custom_app_invite.test", "id", "tf-acc-test-invite-app/1/"+organizationID),
+					resource.TestCheckResourceAttrSet("make_custom_app_invite.test", "created_at"),
+				),
+			},
+			{
+				ResourceName:      "make_custom_app_invite.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCustomAppInviteResourceConfig(organizationID string) string {
+	return `
+resource "make_custom_app" "test" {
+  name      = "tf-acc-test-invite-app"
+  label     = "Test Invite App"
+  published = true
+}
+
+resource "make_custom_app_invite" "test" {
+  app_name        = make_custom_app.test.name
+  app_version     = make_custom_app.test.version
+  organization_id = "` + organizationID + `"
 }
 `
 }
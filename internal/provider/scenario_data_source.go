@@ -19,7 +19,7 @@ func NewScenarioDataSource() datasource.DataSource {
 
 // ScenarioDataSource defines the data source implementation.
 type ScenarioDataSource struct {
-	client *MakeAPIClient
+	client MakeAPI
 }
 
 // ScenarioDataSourceModel describes the data source data model.
@@ -29,6 +29,11 @@ type ScenarioDataSourceModel struct {
 	Description types.String `tfsdk:"description"`
 	Active      types.Bool   `tfsdk:"active"`
 	TeamId      types.String `tfsdk:"team_id"`
+
+	ExecutionRetentionDays types.Int64                   `tfsdk:"execution_retention_days"`
+	ExportExecutionsTo     *ScenarioExecutionExportModel `tfsdk:"export_executions_to"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
 }
 
 func (d *ScenarioDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -61,6 +66,25 @@ func (d *ScenarioDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "Team ID where the scenario belongs",
 				Computed:            true,
 			},
+			"execution_retention_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days the execution history of the scenario is retained",
+				Computed:            true,
+			},
+			"export_executions_to": schema.SingleNestedAttribute{
+				MarkdownDescription: "Target that execution logs of the scenario are exported to",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the export target, either `hook` or `data_store`",
+						Computed:            true,
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Identifier of the hook or data store receiving the execution logs",
+						Computed:            true,
+					},
+				},
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
 		},
 	}
 }
@@ -71,12 +95,12 @@ func (d *ScenarioDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*MakeAPIClient)
+	client, ok := req.ProviderData.(MakeAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *MakeAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -86,6 +110,9 @@ func (d *ScenarioDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *ScenarioDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_scenario", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
 	var data ScenarioDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -95,6 +122,8 @@ func (d *ScenarioDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
 	// Get the scenario from the API
 	scenario, err := d.client.GetScenario(ctx, data.Id.ValueString())
 	if err != nil {
@@ -103,7 +132,7 @@ func (d *ScenarioDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	// Map API response to Terraform state
-	data.Id = types.StringValue(scenario.ID)
+	data.Id = types.StringValue(scenario.ID.String())
 	data.Name = types.StringValue(scenario.Name)
 	data.Active = types.BoolValue(scenario.Active)
 
@@ -114,11 +143,26 @@ func (d *ScenarioDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	if scenario.TeamID != "" {
-		data.TeamId = types.StringValue(scenario.TeamID)
+		data.TeamId = types.StringValue(scenario.TeamID.String())
 	} else {
 		data.TeamId = types.StringNull()
 	}
 
+	if scenario.ExecutionRetentionDays != 0 {
+		data.ExecutionRetentionDays = types.Int64Value(scenario.ExecutionRetentionDays)
+	} else {
+		data.ExecutionRetentionDays = types.Int64Null()
+	}
+
+	if scenario.ExportExecutionsTo != nil {
+		data.ExportExecutionsTo = &ScenarioExecutionExportModel{
+			Type: types.StringValue(scenario.ExportExecutionsTo.Type),
+			Id:   types.StringValue(scenario.ExportExecutionsTo.ID.String()),
+		}
+	} else {
+		data.ExportExecutionsTo = nil
+	}
+
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "read a scenario data source")
 
@@ -2,20 +2,36 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ScenarioResource{}
 var _ resource.ResourceWithImportState = &ScenarioResource{}
+var _ resource.ResourceWithConfigValidators = &ScenarioResource{}
+var _ resource.ResourceWithModifyPlan = &ScenarioResource{}
+var _ resource.ResourceWithValidateConfig = &ScenarioResource{}
+var _ resource.ResourceWithUpgradeState = &ScenarioResource{}
+var _ resource.ResourceWithIdentity = &ScenarioResource{}
 
 func NewScenarioResource() resource.Resource {
 	return &ScenarioResource{}
@@ -23,7 +39,7 @@ func NewScenarioResource() resource.Resource {
 
 // ScenarioResource defines the resource implementation.
 type ScenarioResource struct {
-	client *MakeAPIClient
+	client MakeAPI
 }
 
 // ScenarioResourceModel describes the resource data model.
@@ -33,10 +49,38 @@ type ScenarioResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	Active      types.Bool   `tfsdk:"active"`
 	TeamId      types.String `tfsdk:"team_id"`
+
+	ExecutionRetentionDays types.Int64                   `tfsdk:"execution_retention_days"`
+	ExportExecutionsTo     *ScenarioExecutionExportModel `tfsdk:"export_executions_to"`
+
+	Scheduling      *ScenarioSchedulingModel `tfsdk:"scheduling"`
+	Blueprint       jsontypes.Normalized     `tfsdk:"blueprint"`
+	ManageBlueprint types.Bool               `tfsdk:"manage_blueprint"`
+
+	ForceStopOnDestroy types.Bool `tfsdk:"force_stop_on_destroy"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ScenarioSchedulingModel describes the scheduling data model.
+type ScenarioSchedulingModel struct {
+	Type     types.String `tfsdk:"type"`
+	Interval types.Int64  `tfsdk:"interval"`
+}
+
+// ScenarioExecutionExportModel describes the export_executions_to data model.
+type ScenarioExecutionExportModel struct {
+	Type types.String `tfsdk:"type"`
+	Id   types.String `tfsdk:"id"`
 }
 
 func (r *ScenarioResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_scenario"
+
+	// Scenarios can be moved to another team in place, changing the
+	// scope of their identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *ScenarioResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -44,6 +88,8 @@ func (r *ScenarioResource) Schema(ctx context.Context, req resource.SchemaReques
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Make.com scenario resource",
 
+		Version: 0,
+
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -55,35 +101,221 @@ func (r *ScenarioResource) Schema(ctx context.Context, req resource.SchemaReques
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the scenario",
 				Required:            true,
+				Validators:          nameValidators(),
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the scenario",
 				Optional:            true,
 			},
 			"active": schema.BoolAttribute{
-				MarkdownDescription: "Whether the scenario is active",
-				Optional:            true,
+				MarkdownDescription: "Whether the scenario is active. Plans turning off an active scenario, including " +
+					"by leaving this unset, show a warning.",
+				Optional: true,
 			},
 			"team_id": schema.StringAttribute{
-				MarkdownDescription: "Team ID where the scenario belongs",
+				MarkdownDescription: "Team ID where the scenario belongs. Defaults to the provider `default_team_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"execution_retention_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days the execution history of the scenario is retained. Defaults to the " +
+					"retention of the organization's plan, and is left as it is in Make when removed from the configuration.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"export_executions_to": schema.SingleNestedAttribute{
+				MarkdownDescription: "Target that execution logs of the scenario are exported to, where supported by the organization's " +
+					"plan. Removing it stops the export.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the export target, either `hook` or `data_store`",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("hook", "data_store"),
+						},
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Identifier of the hook or data store receiving the execution logs",
+						Required:            true,
+					},
+				},
+			},
+			"scheduling": schema.SingleNestedAttribute{
+				MarkdownDescription: "Scheduling of the scenario. Only tracked for drift when configured.",
 				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the schedule, one of `indefinitely`, `once`, `daily`, `weekly`, `monthly`, `yearly`, `immediately` or `on-demand`",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("indefinitely", "once", "daily", "weekly", "monthly", "yearly", "immediately", "on-demand"),
+						},
+					},
+					"interval": schema.Int64Attribute{
+						MarkdownDescription: "Interval in seconds between runs when `type` is `indefinitely`. " +
+							"Defaults to the interval Make picks for the schedule.",
+						Optional: true,
+						Computed: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"blueprint": schema.StringAttribute{
+				MarkdownDescription: "Blueprint of the scenario as a JSON string. Key order and formatting are ignored. " +
+					"Must not be set when `manage_blueprint` is `false`.",
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			"manage_blueprint": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform manages the scenario blueprint. Set to `false` to manage only metadata, " +
+					"scheduling and activation while the scenario logic is authored in the Make UI: the blueprint is then " +
+					"never sent nor refreshed, so edits made in Make never show up as drift. Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
 			},
+			"force_stop_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the scenario first stops it when active and waits up to " +
+					"5 minutes, or the `delete` timeout if shorter, for its running executions to finish. " +
+					"Set to `false` to delete the scenario right away. Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
 		},
 	}
 }
 
+func (r *ScenarioResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *ScenarioResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Scenario identifier",
+				RequiredForImport: true,
+			},
+			"team_id": identityschema.StringAttribute{
+				Description:       "Team ID where the scenario belongs",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+func (r *ScenarioResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ScenarioResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only schedules running indefinitely repeat at an interval
+	if data.Scheduling != nil && !data.Scheduling.Interval.IsNull() && !data.Scheduling.Type.IsUnknown() &&
+		data.Scheduling.Type.ValueString() != "indefinitely" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scheduling").AtName("interval"),
+			"Interval Configured for a Non-Repeating Schedule",
+			fmt.Sprintf("The interval attribute only applies when the scheduling type is indefinitely, got %q. "+
+				"Remove the interval or change the type.", data.Scheduling.Type.ValueString()),
+		)
+	}
+
+	if data.Blueprint.IsNull() || data.Blueprint.IsUnknown() {
+		return
+	}
+
+	if !data.ManageBlueprint.IsNull() && !data.ManageBlueprint.IsUnknown() && !data.ManageBlueprint.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("blueprint"),
+			"Unmanaged Blueprint Configured",
+			"The blueprint attribute cannot be set when manage_blueprint is false. Either remove the blueprint "+
+				"or let Terraform manage it.",
+		)
+	}
+}
+
+func (r *ScenarioResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(
+			path.Root("team_id"),
+			path.Root("export_executions_to").AtName("id"),
+		),
+	}
+}
+
+func (r *ScenarioResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.PlanDefaultIDs(ctx, req, resp, defaultID{Path: path.Root("team_id")})
+
+	// Turning off an active scenario stops production automation, which
+	// reviewers of the plan should notice
+	warnScenarioDeactivation(ctx, req, resp)
+
+	planSchedulingInterval(ctx, req, resp)
+
+	refs := []reference{
+		{Path: path.Root("team_id"), Endpoint: "v2/teams/%s"},
+	}
+
+	// The export target is either a webhook or a data store
+	var exportType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("export_executions_to").AtName("type"), &exportType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch exportType.ValueString() {
+	case "hook":
+		refs = append(refs, reference{Path: path.Root("export_executions_to").AtName("id"), Endpoint: "v2/webhooks/%s"})
+	case "data_store":
+		refs = append(refs, reference{Path: path.Root("export_executions_to").AtName("id"), Endpoint: "v2/data-stores/%s"})
+	}
+
+	r.client.CheckReferences(ctx, resp.Plan, &resp.Diagnostics, refs...)
+}
+
 func (r *ScenarioResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
+		r.client = unconfiguredClient
 		return
 	}
 
-	client, ok := req.ProviderData.(*MakeAPIClient)
+	client, ok := req.ProviderData.(MakeAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *MakeAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -93,6 +325,10 @@ func (r *ScenarioResource) Configure(ctx context.Context, req resource.Configure
 }
 
 func (r *ScenarioResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_scenario", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data ScenarioResourceModel
 
 	// Read Terraform plan data into the model
@@ -102,29 +338,31 @@ func (r *ScenarioResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Prepare the API request
-	apiReq := ScenarioRequest{
-		Name:   data.Name.ValueString(),
-		Active: data.Active.ValueBool(),
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Description.IsNull() {
-		apiReq.Description = data.Description.ValueString()
-	}
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
 
-	if !data.TeamId.IsNull() {
-		apiReq.TeamID = data.TeamId.ValueString()
-	}
+	// Prepare the API request
+	apiReq := scenarioRequest(data)
 
 	// Create the scenario via API
+	ctx, etags := makeapi.WithETagRecorder(ctx)
 	scenario, err := r.client.CreateScenario(ctx, apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create scenario, got error: %s", err))
 		return
 	}
 
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag(), UpdatedAt: scenario.LastEdit})...)
+
 	// Map response to Terraform state
-	data.Id = types.StringValue(scenario.ID)
+	data.Id = types.StringValue(scenario.ID.String())
 	data.Name = types.StringValue(scenario.Name)
 	data.Active = types.BoolValue(scenario.Active)
 
@@ -133,7 +371,27 @@ func (r *ScenarioResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	if scenario.TeamID != "" {
-		data.TeamId = types.StringValue(scenario.TeamID)
+		data.TeamId = types.StringValue(scenario.TeamID.String())
+	}
+
+	if scenario.ExecutionRetentionDays != 0 {
+		data.ExecutionRetentionDays = types.Int64Value(scenario.ExecutionRetentionDays)
+	} else {
+		data.ExecutionRetentionDays = types.Int64Null()
+	}
+
+	if scenario.ExportExecutionsTo != nil {
+		data.ExportExecutionsTo = &ScenarioExecutionExportModel{
+			Type: types.StringValue(scenario.ExportExecutionsTo.Type),
+			Id:   types.StringValue(scenario.ExportExecutionsTo.ID.String()),
+		}
+	}
+
+	if data.Scheduling != nil && scenario.Scheduling != nil {
+		data.Scheduling = scenarioSchedulingModel(scenario.Scheduling)
+	}
+	if data.Scheduling != nil && data.Scheduling.Interval.IsUnknown() {
+		data.Scheduling.Interval = types.Int64Null()
 	}
 
 	// Write logs using the tflog package
@@ -144,6 +402,10 @@ func (r *ScenarioResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *ScenarioResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_scenario", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data ScenarioResourceModel
 
 	// Read Terraform prior state data into the model
@@ -153,15 +415,28 @@ func (r *ScenarioResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the scenario from the API
+	ctx, etags := makeapi.WithETagRecorder(ctx)
 	scenario, err := r.client.GetScenario(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scenario, got error: %s", err))
 		return
 	}
 
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag(), UpdatedAt: scenario.LastEdit})...)
+
 	// Map API response to Terraform state
-	data.Id = types.StringValue(scenario.ID)
+	data.Id = types.StringValue(scenario.ID.String())
 	data.Name = types.StringValue(scenario.Name)
 	data.Active = types.BoolValue(scenario.Active)
 
@@ -172,9 +447,49 @@ func (r *ScenarioResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	if scenario.TeamID != "" {
-		data.TeamId = types.StringValue(scenario.TeamID)
+		data.TeamId = types.StringValue(scenario.TeamID.String())
+	}
+
+	if scenario.ExecutionRetentionDays != 0 {
+		data.ExecutionRetentionDays = types.Int64Value(scenario.ExecutionRetentionDays)
 	} else {
-		data.TeamId = types.StringNull()
+		data.ExecutionRetentionDays = types.Int64Null()
+	}
+
+	if scenario.ExportExecutionsTo != nil {
+		data.ExportExecutionsTo = &ScenarioExecutionExportModel{
+			Type: types.StringValue(scenario.ExportExecutionsTo.Type),
+			Id:   types.StringValue(scenario.ExportExecutionsTo.ID.String()),
+		}
+	} else {
+		data.ExportExecutionsTo = nil
+	}
+
+	// Scheduling is only tracked when configured, as Make always reports a
+	// default schedule for scenarios that never had one set
+	if data.Scheduling != nil && scenario.Scheduling != nil {
+		data.Scheduling = scenarioSchedulingModel(scenario.Scheduling)
+	}
+
+	// Imported scenarios have no manage_blueprint nor force_stop_on_destroy
+	// value yet
+	if data.ManageBlueprint.IsNull() {
+		data.ManageBlueprint = types.BoolValue(true)
+	}
+	if data.ForceStopOnDestroy.IsNull() {
+		data.ForceStopOnDestroy = types.BoolValue(true)
+	}
+
+	// Only refresh the blueprint when Terraform owns it. A semantically equal
+	// blueprint keeps the prior value through the jsontypes.Normalized type.
+	if data.ManageBlueprint.ValueBool() && !data.Blueprint.IsNull() {
+		blueprint, err := r.client.GetScenarioBlueprint(ctx, scenario.ID.String())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scenario blueprint, got error: %s", err))
+			return
+		}
+
+		data.Blueprint = jsontypes.NewNormalizedValue(blueprint)
 	}
 
 	// Save updated data into Terraform state
@@ -182,38 +497,80 @@ func (r *ScenarioResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *ScenarioResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ScenarioResourceModel
+	defer recoverPanic(ctx, "make_scenario", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
 
-	// Read Terraform plan data into the model
+	var data, prior ScenarioResourceModel
+
+	// Read Terraform plan data and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Prepare the API request
-	apiReq := ScenarioRequest{
-		Name:   data.Name.ValueString(),
-		Active: data.Active.ValueBool(),
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Description.IsNull() {
-		apiReq.Description = data.Description.ValueString()
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Prepare the API request, which only sends the changed fields
+	apiReq := scenarioRequest(data)
+	priorReq := scenarioRequest(prior)
+
+	// A blueprint no longer managed here is left untouched rather than cleared
+	if apiReq.Blueprint == "" {
+		priorReq.Blueprint = ""
 	}
 
-	if !data.TeamId.IsNull() {
-		apiReq.TeamID = data.TeamId.ValueString()
+	// Refuse to overwrite changes made since Terraform last read the
+	// scenario. Without an ETag, its last edit is compared before writing.
+	version, diags := getObjectVersion(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if version.ETag == "" && version.UpdatedAt != "" {
+		current, err := r.client.GetScenario(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scenario, got error: %s", err))
+			return
+		}
+
+		if current.LastEdit != version.UpdatedAt {
+			addObjectChangedError(&resp.Diagnostics, "scenario", data.Id.ValueString())
+			return
+		}
 	}
 
 	// Update the scenario via API
-	scenario, err := r.client.UpdateScenario(ctx, data.Id.ValueString(), apiReq)
+	ctx = makeapi.WithIfMatch(ctx, version.ETag)
+	ctx, etags := makeapi.WithETagRecorder(ctx)
+	scenario, err := r.client.UpdateScenario(ctx, data.Id.ValueString(), priorReq, apiReq)
 	if err != nil {
+		var conflict *makeapi.ConflictError
+		if errors.As(err, &conflict) {
+			addObjectChangedError(&resp.Diagnostics, "scenario", data.Id.ValueString())
+			return
+		}
+
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update scenario, got error: %s", err))
 		return
 	}
 
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag(), UpdatedAt: scenario.LastEdit})...)
+
 	// Map response to Terraform state
-	data.Id = types.StringValue(scenario.ID)
+	data.Id = types.StringValue(scenario.ID.String())
 	data.Name = types.StringValue(scenario.Name)
 	data.Active = types.BoolValue(scenario.Active)
 
@@ -224,16 +581,43 @@ func (r *ScenarioResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	if scenario.TeamID != "" {
-		data.TeamId = types.StringValue(scenario.TeamID)
+		data.TeamId = types.StringValue(scenario.TeamID.String())
 	} else {
 		data.TeamId = types.StringNull()
 	}
 
+	if scenario.ExecutionRetentionDays != 0 {
+		data.ExecutionRetentionDays = types.Int64Value(scenario.ExecutionRetentionDays)
+	} else {
+		data.ExecutionRetentionDays = types.Int64Null()
+	}
+
+	if scenario.ExportExecutionsTo != nil {
+		data.ExportExecutionsTo = &ScenarioExecutionExportModel{
+			Type: types.StringValue(scenario.ExportExecutionsTo.Type),
+			Id:   types.StringValue(scenario.ExportExecutionsTo.ID.String()),
+		}
+	} else {
+		data.ExportExecutionsTo = nil
+	}
+
+	// Scheduling is only tracked when configured, as Make always reports a
+	// default schedule for scenarios that never had one set
+	if data.Scheduling != nil && scenario.Scheduling != nil {
+		data.Scheduling = scenarioSchedulingModel(scenario.Scheduling)
+	}
+	if data.Scheduling != nil && data.Scheduling.Interval.IsUnknown() {
+		data.Scheduling.Interval = types.Int64Null()
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ScenarioResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_scenario", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
 	var data ScenarioResourceModel
 
 	// Read Terraform prior state data into the model
@@ -243,6 +627,29 @@ func (r *ScenarioResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Stop the scenario and let its running executions finish first, as
+	// deleting an active scenario can fail or leave runs in flight
+	if data.ForceStopOnDestroy.IsNull() || data.ForceStopOnDestroy.ValueBool() {
+		stopped, err := stopScenario(ctx, r.client, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to stop scenario before deleting it, got error: %s", err))
+			return
+		}
+		if !stopped {
+			return
+		}
+	}
+
 	// Delete the scenario via API
 	err := r.client.DeleteScenario(ctx, data.Id.ValueString())
 	if err != nil {
@@ -252,6 +659,183 @@ func (r *ScenarioResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *ScenarioResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "team_id", "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// name=<name> imports the scenario of that name
+	id, err := r.client.ResolveImportName(ctx, req.ID, "scenario", importCandidates(MakeAPI.ListScenarios, func(scenario makeapi.ScenarioResponse) importCandidate {
+		return importCandidate{ID: scenario.ID.String(), Name: scenario.Name}
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Import by Name", err.Error())
+		return
+	}
+
+	// The ID may be prefixed by the team ID, e.g. <team_id>/<id>
+	importScopedID(ctx, id, path.Root("team_id"), resp)
+}
+
+// warnScenarioDeactivation adds a warning when the plan deactivates an active
+// scenario, which leaving active unset does too
+func warnScenarioDeactivation(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var name types.String
+	var wasActive, active types.Bool
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("active"), &wasActive)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("active"), &active)...)
+
+	if resp.Diagnostics.HasError() || !wasActive.ValueBool() || active.IsUnknown() || active.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("active"),
+		"Active Scenario Will Be Deactivated",
+		fmt.Sprintf("Scenario %q is active and will be deactivated, so it no longer runs on its schedule nor "+
+			"on incoming triggers. Set active to true to keep it running.", name.ValueString()),
+	)
+}
+
+// scenarioStopTimeout bounds the wait for the running executions of a scenario
+// being destroyed, unless the delete timeout is shorter
+const scenarioStopTimeout = 5 * time.Minute
+
+// scenarioExecutionsPollInterval is the interval at which the running
+// executions of a scenario being destroyed are checked
+var scenarioExecutionsPollInterval = 5 * time.Second
+
+// stopScenario stops the scenario of the given ID when active and waits for
+// its running executions to finish. It returns false when the scenario no
+// longer exists.
+func stopScenario(ctx context.Context, client MakeAPI, id string) (bool, error) {
+	scenario, err := client.GetScenario(ctx, id)
+	if makeapi.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if scenario.Active {
+		tflog.Info(ctx, "stopping scenario before deleting it", map[string]interface{}{"id": id})
+
+		if _, err := client.StopScenario(ctx, id); err != nil {
+			return false, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, scenarioStopTimeout)
+	defer cancel()
+
+	stillRunning := fmt.Errorf("scenario %s still has running executions after waiting for them to finish; "+
+		"retry later or set force_stop_on_destroy to false", id)
+
+	for {
+		executions, err := client.ListScenarioExecutions(ctx, id, "running")
+		if err != nil && ctx.Err() != nil {
+			// The wait ran out during the request
+			return false, stillRunning
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to list running executions: %w", err)
+		}
+		if len(executions) == 0 {
+			return true, nil
+		}
+
+		tflog.Debug(ctx, "waiting for running executions of scenario", map[string]interface{}{"id": id, "executions": len(executions)})
+
+		select {
+		case <-ctx.Done():
+			return false, stillRunning
+		case <-time.After(scenarioExecutionsPollInterval):
+		}
+	}
+}
+
+// planSchedulingInterval leaves an interval that is not configured unknown
+// when the schedule type changes, as the interval UseStateForUnknown keeps
+// belongs to the previous schedule
+func planSchedulingInterval(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	interval := path.Root("scheduling").AtName("interval")
+	var configInterval types.Int64
+	var planType, stateType types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, interval, &configInterval)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("scheduling").AtName("type"), &planType)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("scheduling").AtName("type"), &stateType)...)
+
+	if resp.Diagnostics.HasError() || !configInterval.IsNull() || planType.IsNull() || planType.Equal(stateType) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, interval, types.Int64Unknown())...)
+}
+
+// scenarioSchedulingModel maps the scheduling of a scenario to its Terraform model
+func scenarioSchedulingModel(scheduling *makeapi.ScenarioScheduling) *ScenarioSchedulingModel {
+	model := &ScenarioSchedulingModel{
+		Type:     types.StringValue(scheduling.Type),
+		Interval: types.Int64Null(),
+	}
+
+	if scheduling.Interval != 0 {
+		model.Interval = types.Int64Value(scheduling.Interval)
+	}
+
+	return model
+}
+
+// scenarioRequest returns the API request for a scenario model
+func scenarioRequest(data ScenarioResourceModel) makeapi.ScenarioRequest {
+	apiReq := makeapi.ScenarioRequest{
+		Name:   data.Name.ValueString(),
+		Active: data.Active.ValueBool(),
+	}
+
+	if !data.Description.IsNull() {
+		apiReq.Description = data.Description.ValueString()
+	}
+
+	if !data.TeamId.IsNull() {
+		apiReq.TeamID = data.TeamId.ValueString()
+	}
+
+	if !data.ExecutionRetentionDays.IsNull() && !data.ExecutionRetentionDays.IsUnknown() {
+		apiReq.ExecutionRetentionDays = data.ExecutionRetentionDays.ValueInt64()
+	}
+
+	if data.ExportExecutionsTo != nil {
+		apiReq.ExportExecutionsTo = &makeapi.ScenarioExecutionExport{
+			Type: data.ExportExecutionsTo.Type.ValueString(),
+			ID:   makeapi.ID(data.ExportExecutionsTo.Id.ValueString()),
+		}
+	}
+
+	if data.Scheduling != nil {
+		apiReq.Scheduling = &makeapi.ScenarioScheduling{
+			Type:     data.Scheduling.Type.ValueString(),
+			Interval: data.Scheduling.Interval.ValueInt64(),
+		}
+	}
+
+	// The blueprint is left untouched in Make unless it is managed here
+	if data.ManageBlueprint.ValueBool() && !data.Blueprint.IsNull() {
+		apiReq.Blueprint = data.Blueprint.ValueString()
+	}
+
+	return apiReq
 }
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ScimUserResource{}
+var _ resource.ResourceWithImportState = &ScimUserResource{}
+var _ resource.ResourceWithUpgradeState = &ScimUserResource{}
+var _ resource.ResourceWithIdentity = &ScimUserResource{}
+
+func NewScimUserResource() resource.Resource {
+	return &ScimUserResource{}
+}
+
+// ScimUserResource defines the resource implementation.
+type ScimUserResource struct {
+	client MakeAPI
+}
+
+// ScimUserResourceModel describes the resource data model.
+type ScimUserResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	UserName            types.String `tfsdk:"user_name"`
+	ExternalId          types.String `tfsdk:"external_id"`
+	GivenName           types.String `tfsdk:"given_name"`
+	FamilyName          types.String `tfsdk:"family_name"`
+	DisplayName         types.String `tfsdk:"display_name"`
+	Email               types.String `tfsdk:"email"`
+	Active              types.Bool   `tfsdk:"active"`
+	DeactivateOnDestroy types.Bool   `tfsdk:"deactivate_on_destroy"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ScimUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scim_user"
+}
+
+func (r *ScimUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "User provisioned through the Make.com SCIM API. Requires an enterprise organization " +
+			"with SCIM provisioning enabled and an API token of an organization administrator.",
+
+		Version: 0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SCIM user identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "Unique user name, usually the email address the user signs in with",
+				Required:            true,
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user in the identity provider",
+				Optional:            true,
+			},
+			"given_name": schema.StringAttribute{
+				MarkdownDescription: "Given name of the user",
+				Optional:            true,
+			},
+			"family_name": schema.StringAttribute{
+				MarkdownDescription: "Family name of the user",
+				Optional:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user as displayed in Make.com. Derived from the given and family names when not set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Primary email address of the user. Defaults to `user_name` in Make.com when not set.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          emailValidators(),
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user can sign in. Setting it to `false` deactivates the user " +
+					"while keeping its data. Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"deactivate_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Deactivate the user instead of deprovisioning it when the resource is destroyed. " +
+					"Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *ScimUserResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *ScimUserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "SCIM user identifier",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *ScimUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ScimUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_scim_user", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data ScimUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Provision the user via API
+	user, err := r.client.CreateScimUser(ctx, data.scimUser())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SCIM user, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.Id = types.StringValue(user.ID)
+	data.setComputed(user)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a SCIM user resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScimUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_scim_user", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data ScimUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the user from the API
+	user, err := r.client.GetScimUser(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SCIM user, got error: %s", err))
+		return
+	}
+
+	// Map API response to Terraform state
+	data.Id = types.StringValue(user.ID)
+	data.UserName = types.StringValue(user.UserName)
+	data.ExternalId = optionalString(user.ExternalID)
+	data.GivenName = optionalString(user.Name.GivenName)
+	data.FamilyName = optionalString(user.Name.FamilyName)
+	data.Active = types.BoolValue(user.Active)
+	data.setComputed(user)
+
+	// Imported users are deprovisioned on destroy unless configured otherwise
+	if data.DeactivateOnDestroy.IsNull() {
+		data.DeactivateOnDestroy = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScimUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer recoverPanic(ctx, "make_scim_user", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data ScimUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Replace the user attributes via API
+	user, err := r.client.UpdateScimUser(ctx, data.Id.ValueString(), data.scimUser())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SCIM user, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.setComputed(user)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScimUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_scim_user", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data ScimUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if data.DeactivateOnDestroy.ValueBool() {
+		user := data.scimUser()
+		user.Active = false
+
+		_, err := r.client.UpdateScimUser(ctx, data.Id.ValueString(), user)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate SCIM user, got error: %s", err))
+		}
+		return
+	}
+
+	// Deprovision the user via API
+	err := r.client.DeleteScimUser(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SCIM user, got error: %s", err))
+		return
+	}
+}
+
+func (r *ScimUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// scimUser builds the SCIM representation of the user
+func (m ScimUserResourceModel) scimUser() makeapi.ScimUser {
+	user := makeapi.ScimUser{
+		ExternalID: m.ExternalId.ValueString(),
+		UserName:   m.UserName.ValueString(),
+		Name: makeapi.ScimUserName{
+			GivenName:  m.GivenName.ValueString(),
+			FamilyName: m.FamilyName.ValueString(),
+		},
+		DisplayName: m.DisplayName.ValueString(),
+		Active:      m.Active.ValueBool(),
+	}
+
+	if email := m.Email.ValueString(); email != "" {
+		user.Emails = []makeapi.ScimEmail{{Value: email, Primary: true}}
+	}
+
+	return user
+}
+
+// setComputed maps the attributes Make.com derives when they are not set
+func (m *ScimUserResourceModel) setComputed(user *makeapi.ScimUser) {
+	m.DisplayName = types.StringValue(user.DisplayName)
+
+	m.Email = types.StringNull()
+	for _, email := range user.Emails {
+		if email.Primary || m.Email.IsNull() {
+			m.Email = types.StringValue(email.Value)
+		}
+	}
+	if m.Email.IsNull() {
+		m.Email = types.StringValue(user.UserName)
+	}
+}
+
+// optionalString maps an empty API value to null
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
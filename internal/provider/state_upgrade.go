@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Every resource declares the Version of its schema and implements
+// resource.ResourceWithUpgradeState. When a schema changes in a way existing
+// state does not fit, e.g. an attribute is renamed or changes type, bump its
+// Version and add an upgrader keyed by each prior version to UpgradeState,
+// converting that state straight to the current schema. TestStateUpgraders
+// checks that no prior version is left without one.
+
+// upgradeStateJSON returns a state upgrader rewriting the raw JSON state of a
+// prior schema version, which spares keeping a copy of the prior schema. The
+// rewritten state must fit the current schema.
+func upgradeStateJSON(upgrade func(state map[string]interface{}) error) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || req.RawState.JSON == nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"The prior state is not in JSON form, which the provider cannot upgrade. Please report this issue to the provider developers.",
+				)
+				return
+			}
+
+			var state map[string]interface{}
+			if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("The prior state could not be decoded: %s", err))
+				return
+			}
+
+			if err := upgrade(state); err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+				return
+			}
+
+			data, err := json.Marshal(state)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("The upgraded state could not be encoded: %s", err))
+				return
+			}
+
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: data}
+		},
+	}
+}
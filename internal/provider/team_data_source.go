@@ -19,14 +19,18 @@ func NewTeamDataSource() datasource.DataSource {
 
 // TeamDataSource defines the data source implementation.
 type TeamDataSource struct {
-	client *MakeAPIClient
+	client MakeAPI
 }
 
 // TeamDataSourceModel describes the data source data model.
 type TeamDataSourceModel struct {
-	Id             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	OrganizationId types.String `tfsdk:"organization_id"`
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	OrganizationId      types.String `tfsdk:"organization_id"`
+	OperationsLimit     types.Int64  `tfsdk:"operations_limit"`
+	DataTransferLimitMB types.Int64  `tfsdk:"data_transfer_limit_mb"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
 }
 
 func (d *TeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -50,6 +54,15 @@ func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "Organization ID where the team belongs",
 				Computed:            true,
 			},
+			"operations_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of operations the team can consume per billing period, null when not capped",
+				Computed:            true,
+			},
+			"data_transfer_limit_mb": schema.Int64Attribute{
+				MarkdownDescription: "Maximum data transfer of the team per billing period in megabytes, null when not capped",
+				Computed:            true,
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
 		},
 	}
 }
@@ -60,12 +73,12 @@ func (d *TeamDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*MakeAPIClient)
+	client, ok := req.ProviderData.(MakeAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *MakeAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -75,6 +88,9 @@ func (d *TeamDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_team", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
 	var data TeamDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -83,21 +99,26 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
 	team, err := d.client.GetTeam(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
 		return
 	}
 
-	data.Id = types.StringValue(team.ID)
+	data.Id = types.StringValue(team.ID.String())
 	data.Name = types.StringValue(team.Name)
 
 	if team.OrganizationID != "" {
-		data.OrganizationId = types.StringValue(team.OrganizationID)
+		data.OrganizationId = types.StringValue(team.OrganizationID.String())
 	} else {
 		data.OrganizationId = types.StringNull()
 	}
 
+	data.OperationsLimit = types.Int64PointerValue(team.OperationsLimit)
+	data.DataTransferLimitMB = types.Int64PointerValue(team.DataTransferLimitMB)
+
 	tflog.Trace(ctx, "read a team data source")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
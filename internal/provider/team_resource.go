@@ -3,19 +3,31 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TeamResource{}
 var _ resource.ResourceWithImportState = &TeamResource{}
+var _ resource.ResourceWithConfigValidators = &TeamResource{}
+var _ resource.ResourceWithModifyPlan = &TeamResource{}
+var _ resource.ResourceWithUpgradeState = &TeamResource{}
+var _ resource.ResourceWithIdentity = &TeamResource{}
 
 func NewTeamResource() resource.Resource {
 	return &TeamResource{}
@@ -23,24 +35,36 @@ func NewTeamResource() resource.Resource {
 
 // TeamResource defines the resource implementation.
 type TeamResource struct {
-	client *MakeAPIClient
+	client MakeAPI
 }
 
 // TeamResourceModel describes the resource data model.
 type TeamResourceModel struct {
-	Id             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	OrganizationId types.String `tfsdk:"organization_id"`
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	OrganizationId      types.String `tfsdk:"organization_id"`
+	OperationsLimit     types.Int64  `tfsdk:"operations_limit"`
+	DataTransferLimitMB types.Int64  `tfsdk:"data_transfer_limit_mb"`
+	ForceDestroy        types.Bool   `tfsdk:"force_destroy"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_team"
+
+	// Teams can be moved to another organization in place, changing the
+	// scope of their identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Make.com team resource",
 
+		Version: 0,
+
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -52,27 +76,108 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the team",
 				Required:            true,
+				Validators:          nameValidators(),
 			},
 			"organization_id": schema.StringAttribute{
-				MarkdownDescription: "Organization ID where the team belongs",
+				MarkdownDescription: "Organization ID where the team belongs. Defaults to the provider `default_organization_id`.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operations_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of operations the team can consume per billing period. " +
+					"When not set, the current limit is kept, which is none for new teams.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"data_transfer_limit_mb": schema.Int64Attribute{
+				MarkdownDescription: "Maximum data transfer of the team per billing period, in megabytes. " +
+					"When not set, the current limit is kept, which is none for new teams.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the team may be destroyed while it still holds scenarios, connections or " +
+					"data stores, which Make deletes along with it. When `false`, destroying a team that is not empty " +
+					"fails listing what it holds. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *TeamResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *TeamResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Team identifier",
+				RequiredForImport: true,
+			},
+			"organization_id": identityschema.StringAttribute{
+				Description:       "Organization ID where the team belongs",
+				OptionalForImport: true,
 			},
 		},
 	}
 }
 
+func (r *TeamResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(path.Root("organization_id")),
+	}
+}
+
+func (r *TeamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.PlanDefaultIDs(ctx, req, resp, defaultID{Path: path.Root("organization_id"), Organization: true})
+
+	r.client.CheckReferences(ctx, resp.Plan, &resp.Diagnostics,
+		reference{Path: path.Root("organization_id"), Endpoint: "v2/organizations/%s"},
+	)
+}
+
 func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
+		r.client = unconfiguredClient
 		return
 	}
 
-	client, ok := req.ProviderData.(*MakeAPIClient)
+	client, ok := req.ProviderData.(MakeAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *MakeAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -82,6 +187,10 @@ func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_team", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data TeamResourceModel
 
 	// Read Terraform plan data into the model
@@ -91,14 +200,18 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	// Prepare the API request
-	apiReq := TeamRequest{
-		Name: data.Name.ValueString(),
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.OrganizationId.IsNull() {
-		apiReq.OrganizationID = data.OrganizationId.ValueString()
-	}
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Prepare the API request
+	apiReq := teamRequest(data)
 
 	// Create the team via API
 	team, err := r.client.CreateTeam(ctx, apiReq)
@@ -108,15 +221,18 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Map response to Terraform state
-	data.Id = types.StringValue(team.ID)
+	data.Id = types.StringValue(team.ID.String())
 	data.Name = types.StringValue(team.Name)
 
 	if team.OrganizationID != "" {
-		data.OrganizationId = types.StringValue(team.OrganizationID)
+		data.OrganizationId = types.StringValue(team.OrganizationID.String())
 	} else {
 		data.OrganizationId = types.StringNull()
 	}
 
+	data.OperationsLimit = types.Int64PointerValue(team.OperationsLimit)
+	data.DataTransferLimitMB = types.Int64PointerValue(team.DataTransferLimitMB)
+
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "created a team resource")
 
@@ -125,6 +241,10 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_team", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data TeamResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -133,60 +253,93 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
 	team, err := r.client.GetTeam(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
 		return
 	}
 
-	data.Id = types.StringValue(team.ID)
+	data.Id = types.StringValue(team.ID.String())
 	data.Name = types.StringValue(team.Name)
 
 	if team.OrganizationID != "" {
-		data.OrganizationId = types.StringValue(team.OrganizationID)
-	} else {
-		data.OrganizationId = types.StringNull()
+		data.OrganizationId = types.StringValue(team.OrganizationID.String())
+	}
+
+	data.OperationsLimit = types.Int64PointerValue(team.OperationsLimit)
+	data.DataTransferLimitMB = types.Int64PointerValue(team.DataTransferLimitMB)
+
+	// Imported teams have no force_destroy value yet
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data TeamResourceModel
+	defer recoverPanic(ctx, "make_team", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data, prior TeamResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	apiReq := TeamRequest{
-		Name: data.Name.ValueString(),
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.OrganizationId.IsNull() {
-		apiReq.OrganizationID = data.OrganizationId.ValueString()
-	}
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
 
-	team, err := r.client.UpdateTeam(ctx, data.Id.ValueString(), apiReq)
+	// Prepare the API request, which only sends the changed fields
+	apiReq := teamRequest(data)
+	priorReq := teamRequest(prior)
+
+	team, err := r.client.UpdateTeam(ctx, data.Id.ValueString(), priorReq, apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team, got error: %s", err))
 		return
 	}
 
-	data.Id = types.StringValue(team.ID)
+	data.Id = types.StringValue(team.ID.String())
 	data.Name = types.StringValue(team.Name)
 
 	if team.OrganizationID != "" {
-		data.OrganizationId = types.StringValue(team.OrganizationID)
+		data.OrganizationId = types.StringValue(team.OrganizationID.String())
 	} else {
 		data.OrganizationId = types.StringNull()
 	}
 
+	data.OperationsLimit = types.Int64PointerValue(team.OperationsLimit)
+	data.DataTransferLimitMB = types.Int64PointerValue(team.DataTransferLimitMB)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_team", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
 	var data TeamResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -195,6 +348,34 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Make deletes the content of a team along with it
+	if !data.ForceDestroy.ValueBool() {
+		objects, err := teamObjects(ctx, r.client, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check whether the team is empty, got error: %s", err))
+			return
+		}
+
+		if len(objects) > 0 {
+			resp.Diagnostics.AddError(
+				"Team Not Empty",
+				fmt.Sprintf("The team still holds %s, which Make would delete along with it. Delete or move them first, "+
+					"or set force_destroy to true to delete the team with its content.", objectList(objects, teamObjectsListed)),
+			)
+			return
+		}
+	}
+
 	err := r.client.DeleteTeam(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team, got error: %s", err))
@@ -205,5 +386,80 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "organization_id", "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID may be prefixed by the organization ID, e.g. <organization_id>/<id>
+	importScopedID(ctx, req.ID, path.Root("organization_id"), resp)
+}
+
+// teamRequest returns the API request for a team model
+func teamRequest(data TeamResourceModel) makeapi.TeamRequest {
+	apiReq := makeapi.TeamRequest{
+		Name: data.Name.ValueString(),
+	}
+
+	if !data.OrganizationId.IsNull() {
+		apiReq.OrganizationID = data.OrganizationId.ValueString()
+	}
+
+	// Unknown limits are not configured and keep their current value
+	if !data.OperationsLimit.IsUnknown() {
+		apiReq.OperationsLimit = data.OperationsLimit.ValueInt64Pointer()
+	}
+
+	if !data.DataTransferLimitMB.IsUnknown() {
+		apiReq.DataTransferLimitMB = data.DataTransferLimitMB.ValueInt64Pointer()
+	}
+
+	return apiReq
+}
+
+// teamObjectsListed is the number of objects named when refusing to delete a
+// team that is not empty
+const teamObjectsListed = 10
+
+// teamObjects returns the scenarios, connections and data stores left in the
+// team of the given ID, e.g. scenario "Orders" (ID 12)
+func teamObjects(ctx context.Context, client MakeAPI, teamID string) ([]string, error) {
+	var objects []string
+
+	scenarios, err := client.ListScenarios(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scenarios: %w", err)
+	}
+	for _, scenario := range scenarios {
+		objects = append(objects, fmt.Sprintf("scenario %q (ID %s)", scenario.Name, scenario.ID))
+	}
+
+	connections, err := client.ListConnections(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connections: %w", err)
+	}
+	for _, connection := range connections {
+		objects = append(objects, fmt.Sprintf("connection %q (ID %s)", connection.Name, connection.ID))
+	}
+
+	dataStores, err := client.ListDataStores(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list data stores: %w", err)
+	}
+	for _, dataStore := range dataStores {
+		objects = append(objects, fmt.Sprintf("data store %q (ID %s)", dataStore.Name, dataStore.ID))
+	}
+
+	return objects, nil
+}
+
+// objectList joins the first limit objects for diagnostics, counting the others
+func objectList(objects []string, limit int) string {
+	if len(objects) <= limit {
+		return strings.Join(objects, ", ")
+	}
+
+	return fmt.Sprintf("%s and %d more", strings.Join(objects[:limit], ", "), len(objects)-limit)
 }
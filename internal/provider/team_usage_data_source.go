@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TeamUsageDataSource{}
+
+func NewTeamUsageDataSource() datasource.DataSource {
+	return &TeamUsageDataSource{}
+}
+
+// TeamUsageDataSource defines the data source implementation.
+type TeamUsageDataSource struct {
+	client MakeAPI
+}
+
+// TeamUsageDataSourceModel describes the data source data model.
+type TeamUsageDataSourceModel struct {
+	TeamId         types.String `tfsdk:"team_id"`
+	From           types.String `tfsdk:"from"`
+	To             types.String `tfsdk:"to"`
+	Operations     types.Int64  `tfsdk:"operations"`
+	DataTransferMB types.Int64  `tfsdk:"data_transfer_mb"`
+	Days           types.List   `tfsdk:"days"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// dailyUsageAttrTypes are the attribute types of an entry in a days list
+var dailyUsageAttrTypes = map[string]attr.Type{
+	"date":             types.StringType,
+	"operations":       types.Int64Type,
+	"data_transfer_mb": types.Int64Type,
+}
+
+func (d *TeamUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_usage"
+}
+
+func (d *TeamUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Operations and data transfer consumed by a Make.com team over a period, e.g. for " +
+			"chargeback reports. Without `from` and `to`, the current billing period is reported.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team",
+				Required:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Start of the period as an RFC 3339 timestamp",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "End of the period as an RFC 3339 timestamp",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"operations": schema.Int64Attribute{
+				MarkdownDescription: "Total number of operations consumed in the period",
+				Computed:            true,
+			},
+			"data_transfer_mb": schema.Int64Attribute{
+				MarkdownDescription: "Total data transfer in the period in megabytes",
+				Computed:            true,
+			},
+			"days": schema.ListNestedAttribute{
+				MarkdownDescription: "Consumption per day, oldest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Day of the consumption in `YYYY-MM-DD` format",
+							Computed:            true,
+						},
+						"operations": schema.Int64Attribute{
+							MarkdownDescription: "Number of operations consumed on the day",
+							Computed:            true,
+						},
+						"data_transfer_mb": schema.Int64Attribute{
+							MarkdownDescription: "Data transfer on the day in megabytes",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *TeamUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_team_usage", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data TeamUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	usage, err := d.client.GetTeamUsage(ctx, data.TeamId.ValueString(), data.From.ValueString(), data.To.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team usage, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	data.Operations, data.DataTransferMB, data.Days = dailyUsageValues(usage)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a team usage data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// dailyUsageValues returns the total operations and data transfer of the
+// daily usage together with the list of days
+func dailyUsageValues(usage []makeapi.DailyUsage) (types.Int64, types.Int64, types.List) {
+	var operations, dataTransfer int64
+	days := make([]attr.Value, 0, len(usage))
+	for _, day := range usage {
+		operations += day.Operations
+		dataTransfer += day.DataTransferMB
+		days = append(days, types.ObjectValueMust(dailyUsageAttrTypes, map[string]attr.Value{
+			"date":             types.StringValue(day.Date),
+			"operations":       types.Int64Value(day.Operations),
+			"data_transfer_mb": types.Int64Value(day.DataTransferMB),
+		}))
+	}
+
+	return types.Int64Value(operations), types.Int64Value(dataTransfer),
+		types.ListValueMust(types.ObjectType{AttrTypes: dailyUsageAttrTypes}, days)
+}
@@ -0,0 +1,420 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamVariableResource{}
+var _ resource.ResourceWithImportState = &TeamVariableResource{}
+var _ resource.ResourceWithConfigValidators = &TeamVariableResource{}
+var _ resource.ResourceWithModifyPlan = &TeamVariableResource{}
+var _ resource.ResourceWithValidateConfig = &TeamVariableResource{}
+var _ resource.ResourceWithUpgradeState = &TeamVariableResource{}
+var _ resource.ResourceWithIdentity = &TeamVariableResource{}
+
+func NewTeamVariableResource() resource.Resource {
+	return &TeamVariableResource{}
+}
+
+// TeamVariableResource defines the resource implementation.
+type TeamVariableResource struct {
+	client MakeAPI
+}
+
+// TeamVariableResourceModel describes the resource data model.
+type TeamVariableResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	TeamId   types.String `tfsdk:"team_id"`
+	Name     types.String `tfsdk:"name"`
+	Value    types.String `tfsdk:"value"`
+	Type     types.String `tfsdk:"type"`
+	IsSecret types.Bool   `tfsdk:"is_secret"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *TeamVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_variable"
+}
+
+func (r *TeamVariableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Make.com team custom variable resource. The value is always treated as sensitive. " +
+			"Make.com never returns the value of secret variables, so changes made to them outside of Terraform " +
+			"cannot be detected.",
+
+		Version: 0,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Team variable identifier in the form `<team_id>/<name>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team the variable belongs to. Defaults to the provider `default_team_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the variable",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Value of the variable. Numbers and booleans are given in their string form, e.g. `\"42\"` or `\"true\"`.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the variable: `string`, `number`, `boolean` or `date`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						makeapi.CustomVariableTypeString,
+						makeapi.CustomVariableTypeNumber,
+						makeapi.CustomVariableTypeBoolean,
+						makeapi.CustomVariableTypeDate,
+					),
+				},
+			},
+			"is_secret": schema.BoolAttribute{
+				MarkdownDescription: "Whether the variable is secret. Secret values are hidden in Make.com and never " +
+					"read back. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *TeamVariableResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *TeamVariableResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"team_id": identityschema.StringAttribute{
+				Description:       "ID of the team the variable belongs to",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "Name of the variable",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *TeamVariableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamVariableResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Value.IsNull() || data.Value.IsUnknown() || data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+
+	if _, err := customVariableValue(data.Type.ValueString(), data.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid Variable Value",
+			fmt.Sprintf("The value does not match the variable type %q: %s", data.Type.ValueString(), err),
+		)
+	}
+}
+
+func (r *TeamVariableResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(path.Root("team_id")),
+	}
+}
+
+func (r *TeamVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.PlanDefaultIDs(ctx, req, resp, defaultID{Path: path.Root("team_id"), Required: true})
+
+	r.client.CheckReferences(ctx, resp.Plan, &resp.Diagnostics,
+		reference{Path: path.Root("team_id"), Endpoint: "v2/teams/%s"},
+	)
+}
+
+func (r *TeamVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		r.client = unconfiguredClient
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_team_variable", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data TeamVariableResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Prepare the API request
+	value, err := customVariableValue(data.Type.ValueString(), data.Value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid Variable Value", err.Error())
+		return
+	}
+
+	apiReq := makeapi.CustomVariableRequest{
+		Name:     data.Name.ValueString(),
+		Value:    value,
+		Type:     data.Type.ValueString(),
+		IsSecret: data.IsSecret.ValueBool(),
+	}
+
+	// Create the variable via API
+	variable, err := r.client.CreateTeamVariable(ctx, data.TeamId.ValueString(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team variable, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state. The value is kept from the plan.
+	data.Id = types.StringValue(teamVariableID(data.TeamId.ValueString(), variable.Name))
+	data.Type = types.StringValue(variable.Type)
+	data.IsSecret = types.BoolValue(variable.IsSecret)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a team variable resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_team_variable", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data TeamVariableResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the variable from the API
+	variable, err := r.client.GetTeamVariable(ctx, data.TeamId.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team variable, got error: %s", err))
+		return
+	}
+
+	// Map API response to Terraform state
+	data.Id = types.StringValue(teamVariableID(data.TeamId.ValueString(), variable.Name))
+	data.Type = types.StringValue(variable.Type)
+	data.IsSecret = types.BoolValue(variable.IsSecret)
+
+	// Secret values are never returned, so the value in state is kept. Other
+	// values are only replaced when they differ, e.g. "1.50" and 1.5 are equal.
+	if !variable.IsSecret && variable.Value != nil {
+		prior, err := customVariableValue(variable.Type, data.Value.ValueString())
+		if data.Value.IsNull() || err != nil || !reflect.DeepEqual(prior, variable.Value) {
+			data.Value = types.StringValue(valueToString(variable.Value))
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer recoverPanic(ctx, "make_team_variable", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data TeamVariableResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Prepare the API request
+	value, err := customVariableValue(data.Type.ValueString(), data.Value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid Variable Value", err.Error())
+		return
+	}
+
+	apiReq := makeapi.CustomVariableRequest{
+		Value:    value,
+		Type:     data.Type.ValueString(),
+		IsSecret: data.IsSecret.ValueBool(),
+	}
+
+	// Update the variable via API
+	variable, err := r.client.UpdateTeamVariable(ctx, data.TeamId.ValueString(), data.Name.ValueString(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team variable, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state. The value is kept from the plan.
+	data.Type = types.StringValue(variable.Type)
+	data.IsSecret = types.BoolValue(variable.IsSecret)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_team_variable", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data TeamVariableResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the variable via API
+	err := r.client.DeleteTeamVariable(ctx, data.TeamId.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team variable, got error: %s", err))
+		return
+	}
+}
+
+func (r *TeamVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "team_id", "name")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, name, ok := strings.Cut(req.ID, "/")
+	if !ok || teamID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <team_id>/<name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// teamVariableID builds the identifier of a team variable
+func teamVariableID(teamID, name string) string {
+	return teamID + "/" + name
+}
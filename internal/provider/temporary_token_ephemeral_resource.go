@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &TemporaryTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &TemporaryTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &TemporaryTokenEphemeralResource{}
+
+// temporaryTokenPrivateKey is the private data key holding the ID of the
+// token to revoke on close
+const temporaryTokenPrivateKey = "token_id"
+
+// temporaryTokenProfilePrivateKey is the private data key holding the auth
+// profile the token was created with
+const temporaryTokenProfilePrivateKey = "auth_profile"
+
+func NewTemporaryTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &TemporaryTokenEphemeralResource{}
+}
+
+// TemporaryTokenEphemeralResource defines the ephemeral resource implementation.
+type TemporaryTokenEphemeralResource struct {
+	client MakeAPI
+}
+
+// TemporaryTokenEphemeralResourceModel describes the ephemeral resource data model.
+type TemporaryTokenEphemeralResourceModel struct {
+	Label  types.String `tfsdk:"label"`
+	Scopes types.List   `tfsdk:"scopes"`
+	Id     types.String `tfsdk:"id"`
+	Token  types.String `tfsdk:"token"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+func (e *TemporaryTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_temporary_token"
+}
+
+func (e *TemporaryTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Short-lived Make.com API token, created when Terraform needs it and revoked as soon as " +
+			"Terraform is done with it. The token is never written to plan or state, making it suitable to pass to " +
+			"other providers or provisioners. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Label of the temporary API token. Defaults to `terraform-temporary-token`.",
+				Optional:            true,
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes granted to the temporary API token, e.g. `scenarios:read`",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Temporary API token identifier",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Secret value of the temporary API token",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"auth_profile": authProfileEphemeralAttribute(),
+		},
+	}
+}
+
+func (e *TemporaryTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+func (e *TemporaryTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer recoverPanic(ctx, "make_temporary_token", "open", nil, &resp.Diagnostics)
+	defer e.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data TemporaryTokenEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	// Prepare the API request
+	apiReq := makeapi.APITokenRequest{
+		Label: "terraform-temporary-token",
+	}
+
+	if !data.Label.IsNull() {
+		apiReq.Label = data.Label.ValueString()
+	}
+
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &apiReq.Scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the API token via API
+	token, err := e.client.CreateAPIToken(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create temporary API token, got error: %s", err))
+		return
+	}
+
+	// Remember the token so that Close can revoke it
+	tokenID, err := json.Marshal(token.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode temporary API token ID, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, temporaryTokenPrivateKey, tokenID)...)
+
+	if !data.AuthProfile.IsNull() {
+		profile, err := json.Marshal(data.AuthProfile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode auth profile, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, temporaryTokenProfilePrivateKey, profile)...)
+	}
+
+	// Map response to the ephemeral result
+	data.Label = types.StringValue(apiReq.Label)
+	data.Id = types.StringValue(token.ID.String())
+	data.Token = types.StringValue(token.Token)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "opened a temporary token ephemeral resource")
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *TemporaryTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	defer recoverPanic(ctx, "make_temporary_token", "close", nil, &resp.Diagnostics)
+	defer e.client.AddQuotaWarning(&resp.Diagnostics)
+
+	value, diags := req.Private.GetKey(ctx, temporaryTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || value == nil {
+		return
+	}
+
+	var tokenID string
+	if err := json.Unmarshal(value, &tokenID); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode temporary API token ID, got error: %s", err))
+		return
+	}
+
+	// Revoke the token with the credentials it was created with
+	profile, diags := req.Private.GetKey(ctx, temporaryTokenProfilePrivateKey)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if profile != nil {
+		var name string
+		if err := json.Unmarshal(profile, &name); err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode auth profile, got error: %s", err))
+			return
+		}
+		ctx = withAuthProfile(ctx, types.StringValue(name))
+	}
+
+	// Revoke the API token via API
+	if err := e.client.DeleteAPIToken(ctx, tokenID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke temporary API token, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "closed a temporary token ephemeral resource")
+}
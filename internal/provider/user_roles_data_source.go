@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserRolesDataSource{}
+
+func NewUserRolesDataSource() datasource.DataSource {
+	return &UserRolesDataSource{}
+}
+
+// UserRolesDataSource defines the data source implementation.
+type UserRolesDataSource struct {
+	client MakeAPI
+}
+
+// UserRolesDataSourceModel describes the data source data model.
+type UserRolesDataSourceModel struct {
+	Category types.String `tfsdk:"category"`
+	Roles    types.List   `tfsdk:"roles"`
+	Ids      types.Map    `tfsdk:"ids"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// userRoleAttrTypes are the attribute types of a role in the roles list
+var userRoleAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"category":    types.StringType,
+	"permissions": types.ListType{ElemType: types.StringType},
+}
+
+func (d *UserRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_roles"
+}
+
+func (d *UserRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "User roles available in Make.com, so that role assignments can reference roles by name",
+
+		Attributes: map[string]schema.Attribute{
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Only return roles of this category, e.g. `team` or `organization`",
+				Optional:            true,
+			},
+			"roles": schema.ListNestedAttribute{
+				MarkdownDescription: "Available user roles, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Role identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the role, e.g. `team_admin`",
+							Computed:            true,
+						},
+						"category": schema.StringAttribute{
+							MarkdownDescription: "Category of the role, e.g. `team` or `organization`",
+							Computed:            true,
+						},
+						"permissions": schema.ListAttribute{
+							MarkdownDescription: "Permissions granted by the role, sorted",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				MarkdownDescription: "Role identifiers keyed by role name",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *UserRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_user_roles", "read", nil, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data UserRolesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	roles, err := d.client.ListUserRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list user roles, got error: %s", err))
+		return
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+
+	// Map response to Terraform state
+	roleValues := make([]attr.Value, 0, len(roles))
+	ids := make(map[string]attr.Value, len(roles))
+	for _, role := range roles {
+		if !data.Category.IsNull() && role.Category != data.Category.ValueString() {
+			continue
+		}
+
+		permissions := sortedStringListValue(role.Permissions)
+		if permissions.IsNull() {
+			permissions = types.ListValueMust(types.StringType, []attr.Value{})
+		}
+
+		roleValues = append(roleValues, types.ObjectValueMust(userRoleAttrTypes, map[string]attr.Value{
+			"id":          types.StringValue(role.ID.String()),
+			"name":        types.StringValue(role.Name),
+			"category":    types.StringValue(role.Category),
+			"permissions": permissions,
+		}))
+		ids[role.Name] = types.StringValue(role.ID.String())
+	}
+
+	data.Roles = types.ListValueMust(types.ObjectType{AttrTypes: userRoleAttrTypes}, roleValues)
+	data.Ids = types.MapValueMust(types.StringType, ids)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a user roles data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
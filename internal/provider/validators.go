@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ validator.String = ipOrCIDRValidator{}
+var _ validator.String = jsonObjectValidator{}
+var _ validator.Dynamic = dynamicObjectValidator{}
+var _ validator.String = rfc3339Validator{}
+
+// maxNameLength is the longest name Make accepts for scenarios, connections,
+// webhooks and the other objects named by users
+const maxNameLength = 128
+
+// nonBlankPattern matches strings holding more than whitespace
+var nonBlankPattern = regexp.MustCompile(`\S`)
+
+// appNamePattern matches the names of Make apps, e.g. gmail or google-sheets
+var appNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_#-]*$`)
+
+// emailPattern loosely matches email addresses, catching names or IDs given
+// in their place
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// nameValidators returns the validators of the names of Make objects, which
+// the API rejects when blank or longer than maxNameLength
+func nameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, maxNameLength),
+		stringvalidator.RegexMatches(nonBlankPattern, "must not be blank"),
+	}
+}
+
+// appNameValidators returns the validators of attributes holding the name of
+// a Make app
+func appNameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(appNamePattern, "must be the name of a Make app, e.g. gmail or google-sheets, rather than its label"),
+	}
+}
+
+// emailValidators returns the validators of attributes holding an email
+// address
+func emailValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(emailPattern, "must be an email address"),
+	}
+}
+
+// ipOrCIDRValidator validates that a string is an IPv4/IPv6 address or a CIDR
+// range.
+type ipOrCIDRValidator struct{}
+
+// ipOrCIDR returns a validator which ensures that a string attribute holds an
+// IP address or CIDR range.
+func ipOrCIDR() validator.String {
+	return ipOrCIDRValidator{}
+}
+
+func (v ipOrCIDRValidator) Description(ctx context.Context) string {
+	return "value must be an IP address or CIDR range"
+}
+
+func (v ipOrCIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipOrCIDRValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if net.ParseIP(value) != nil {
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid IP Address or CIDR Range",
+		fmt.Sprintf("The value %q is neither an IP address (e.g. 203.0.113.10) nor a CIDR range (e.g. 203.0.113.0/24).", value),
+	)
+}
+
+// jsonObjectValidator validates that a string is a JSON object.
+type jsonObjectValidator struct{}
+
+// jsonObject returns a validator which ensures that a string attribute holds
+// a JSON-encoded object.
+func jsonObject() validator.String {
+	return jsonObjectValidator{}
+}
+
+func (v jsonObjectValidator) Description(ctx context.Context) string {
+	return "value must be a JSON object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &object); err != nil || object == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object",
+			"The value must be a JSON object, e.g. jsonencode({ name = \"value\" }).",
+		)
+	}
+}
+
+// dynamicObjectValidator validates that a dynamic value is an object or a map.
+type dynamicObjectValidator struct{}
+
+// dynamicObject returns a validator which ensures that a dynamic attribute
+// holds an object or a map.
+func dynamicObject() validator.Dynamic {
+	return dynamicObjectValidator{}
+}
+
+func (v dynamicObjectValidator) Description(ctx context.Context) string {
+	return "value must be an object"
+}
+
+func (v dynamicObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dynamicObjectValidator) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() ||
+		req.ConfigValue.IsUnderlyingValueNull() || req.ConfigValue.IsUnderlyingValueUnknown() {
+		return
+	}
+
+	switch req.ConfigValue.UnderlyingValue().(type) {
+	case basetypes.ObjectValue, basetypes.MapValue:
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Object",
+		"The value must be an object, e.g. { url = \"https://example.com\" }.",
+	)
+}
+
+// rfc3339Validator validates that a string is an RFC 3339 timestamp.
+type rfc3339Validator struct{}
+
+// rfc3339 returns a validator which ensures that a string attribute holds an
+// RFC 3339 timestamp.
+func rfc3339() validator.String {
+	return rfc3339Validator{}
+}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be an RFC 3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("The value %q must be an RFC 3339 timestamp, e.g. 2025-01-31T00:00:00Z or timestamp().", req.ConfigValue.ValueString()),
+		)
+	}
+}
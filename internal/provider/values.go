@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
+)
+
+// convertSettingsToStringMap converts a map[string]interface{} to map[string]attr.Value
+// with explicit type handling for better string representations
+func convertSettingsToStringMap(settings map[string]interface{}) map[string]attr.Value {
+	settingsVals := make(map[string]attr.Value, len(settings))
+	for k, v := range settings {
+		settingsVals[k] = types.StringValue(valueToString(v))
+	}
+	return settingsVals
+}
+
+// valueToString converts a decoded JSON value to its string representation
+func valueToString(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case fmt.Stringer:
+		return val.String()
+	case int, int8, int16, int32, int64:
+		return fmt.Sprintf("%d", val)
+	case uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", val)
+	case float32, float64:
+		return fmt.Sprintf("%g", val)
+	case bool:
+		return fmt.Sprintf("%t", val)
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+// jsonEqual reports whether two JSON documents are semantically equal, ignoring
+// key order and whitespace. Invalid JSON is only equal to an identical string.
+func jsonEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var aVal, bVal interface{}
+	if err := json.Unmarshal([]byte(a), &aVal); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bVal); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aVal, bVal)
+}
+
+// sortedStrings returns a sorted copy of values, leaving the input untouched
+func sortedStrings(values []string) []string {
+	sorted := make([]string, len(values))
+	copy(sorted, values)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// sortedStringListValue converts a slice of strings into a types.List with its
+// elements sorted, so that list attributes are stored deterministically and a
+// refresh never produces an order-only diff. A nil slice maps to a null list.
+func sortedStringListValue(values []string) types.List {
+	if values == nil {
+		return types.ListNull(types.StringType)
+	}
+
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range sortedStrings(values) {
+		elements = append(elements, types.StringValue(v))
+	}
+
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// stringListValueLike converts a slice of strings into a types.List, keeping
+// prior as is when it holds the same elements in a different order. This lets
+// list attributes whose order Make does not preserve retain the configured
+// order, while any actual change is stored sorted via sortedStringListValue.
+func stringListValueLike(ctx context.Context, prior types.List, values []string) types.List {
+	if prior.IsNull() || prior.IsUnknown() || values == nil {
+		return sortedStringListValue(values)
+	}
+
+	var priorValues []string
+	if diags := prior.ElementsAs(ctx, &priorValues, false); diags.HasError() {
+		return sortedStringListValue(values)
+	}
+
+	if reflect.DeepEqual(sortedStrings(priorValues), sortedStrings(values)) {
+		return prior
+	}
+
+	return sortedStringListValue(values)
+}
+
+// customVariableValue converts the string representation of a custom variable
+// value into the JSON type the API expects for the variable type
+func customVariableValue(variableType, value string) (interface{}, error) {
+	switch variableType {
+	case makeapi.CustomVariableTypeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid number", value)
+		}
+		return number, nil
+	case makeapi.CustomVariableTypeBoolean:
+		boolean, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid boolean", value)
+		}
+		return boolean, nil
+	default:
+		return value, nil
+	}
+}
+
+// dynamicValueToJSON converts a known Terraform value, e.g. the underlying
+// value of a dynamic attribute, into the JSON value the API expects. Numbers
+// keep their precision.
+func dynamicValueToJSON(value attr.Value) (interface{}, error) {
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	var elements []attr.Value
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return dynamicValueToJSON(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		return json.Number(v.ValueBigFloat().Text('g', -1)), nil
+	case basetypes.Int64Value:
+		return v.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.ObjectValue:
+		return dynamicAttributesToJSON(v.Attributes())
+	case basetypes.MapValue:
+		return dynamicAttributesToJSON(v.Elements())
+	case basetypes.ListValue:
+		elements = v.Elements()
+	case basetypes.SetValue:
+		elements = v.Elements()
+	case basetypes.TupleValue:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("unsupported value of type %s", value.Type(context.Background()))
+	}
+
+	result := make([]interface{}, 0, len(elements))
+	for _, element := range elements {
+		converted, err := dynamicValueToJSON(element)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+
+	return result, nil
+}
+
+// dynamicAttributesToJSON converts the attributes of an object or the elements
+// of a map into a JSON object, leaving out null values
+func dynamicAttributesToJSON(attributes map[string]attr.Value) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(attributes))
+	for name, value := range attributes {
+		converted, err := dynamicValueToJSON(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if converted != nil {
+			result[name] = converted
+		}
+	}
+
+	return result, nil
+}
+
+// dynamicValueFromJSON converts a decoded JSON value into a Terraform value
+// fit for a dynamic attribute: objects map to objects and arrays to tuples.
+// Null object members are left out and null array elements become null
+// strings, since Terraform values need a type.
+func dynamicValueFromJSON(value interface{}) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), nil
+	case json.Number:
+		number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s: %w", v, err)
+		}
+		return types.NumberValue(number), nil
+	case []interface{}:
+		elementTypes := make([]attr.Type, 0, len(v))
+		elements := make([]attr.Value, 0, len(v))
+		for _, element := range v {
+			converted, err := dynamicValueFromJSON(element)
+			if err != nil {
+				return nil, err
+			}
+			elementTypes = append(elementTypes, converted.Type(context.Background()))
+			elements = append(elements, converted)
+		}
+
+		tuple, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("invalid array: %v", diags)
+		}
+		return tuple, nil
+	case map[string]interface{}:
+		attributeTypes := make(map[string]attr.Type, len(v))
+		attributes := make(map[string]attr.Value, len(v))
+		for name, member := range v {
+			if member == nil {
+				continue
+			}
+
+			converted, err := dynamicValueFromJSON(member)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			attributeTypes[name] = converted.Type(context.Background())
+			attributes[name] = converted
+		}
+
+		object, diags := types.ObjectValue(attributeTypes, attributes)
+		if diags.HasError() {
+			return nil, fmt.Errorf("invalid object: %v", diags)
+		}
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported JSON value of type %T", value)
+	}
+}
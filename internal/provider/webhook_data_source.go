@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WebhookDataSource{}
+
+func NewWebhookDataSource() datasource.DataSource {
+	return &WebhookDataSource{}
+}
+
+// WebhookDataSource defines the data source implementation.
+type WebhookDataSource struct {
+	client MakeAPI
+}
+
+// WebhookDataSourceModel describes the data source data model.
+type WebhookDataSourceModel struct {
+	Id       types.String          `tfsdk:"id"`
+	Name     types.String          `tfsdk:"name"`
+	URL      types.String          `tfsdk:"url"`
+	Email    types.String          `tfsdk:"email"`
+	Type     types.String          `tfsdk:"type"`
+	Status   types.String          `tfsdk:"status"`
+	TeamId   types.String          `tfsdk:"team_id"`
+	Active   types.Bool            `tfsdk:"active"`
+	Settings *WebhookSettingsModel `tfsdk:"settings"`
+	Queue    *WebhookQueueModel    `tfsdk:"queue"`
+
+	DataStructureId types.String `tfsdk:"data_structure_id"`
+	LearningMode    types.Bool   `tfsdk:"learning_mode"`
+	IPRestrictions  types.List   `tfsdk:"ip_restrictions"`
+
+	Response *WebhookReplyModel `tfsdk:"response"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+func (d *WebhookDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (d *WebhookDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Make.com webhook data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Webhook identifier",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the webhook",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL endpoint for the webhook, set when `type` is `" + webhookTypeGateway + "`",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the mailhook, set when `type` is `" + webhookTypeMailhook + "`",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the webhook, either `" + webhookTypeGateway + "` or `" + webhookTypeMailhook + "`",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the webhook as reported by Make",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team ID where the webhook belongs",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the webhook is active",
+				Computed:            true,
+			},
+			"data_structure_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the data structure incoming payloads are validated against",
+				Computed:            true,
+			},
+			"learning_mode": schema.BoolAttribute{
+				MarkdownDescription: "Whether the webhook is in \"determine data structure\" learning mode",
+				Computed:            true,
+			},
+			"ip_restrictions": schema.ListAttribute{
+				MarkdownDescription: "IP addresses and CIDR ranges allowed to call the webhook, sorted",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"response": schema.SingleNestedAttribute{
+				MarkdownDescription: "Custom response the webhook replies with once it accepted a request",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"status_code": schema.Int64Attribute{
+						MarkdownDescription: "HTTP status code of the response",
+						Computed:            true,
+					},
+					"body": schema.StringAttribute{
+						MarkdownDescription: "Body of the response",
+						Computed:            true,
+					},
+					"headers": schema.MapAttribute{
+						MarkdownDescription: "Headers of the response",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Advanced settings controlling which parts of an incoming request reach the scenario",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"method": schema.BoolAttribute{
+						MarkdownDescription: "Whether the HTTP method of the request is passed to the scenario",
+						Computed:            true,
+					},
+					"headers": schema.BoolAttribute{
+						MarkdownDescription: "Whether the headers of the request are passed to the scenario",
+						Computed:            true,
+					},
+					"stringify": schema.BoolAttribute{
+						MarkdownDescription: "Whether a JSON payload is passed to the scenario as a string instead of being parsed",
+						Computed:            true,
+					},
+				},
+			},
+			"queue": schema.SingleNestedAttribute{
+				MarkdownDescription: "Options of the queue keeping incoming requests until the scenario processes them",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_size": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of requests in the queue, null when not limited",
+						Computed:            true,
+					},
+					"stop_on_error": schema.BoolAttribute{
+						MarkdownDescription: "Whether processing of the queue stops when the scenario fails to process a request",
+						Computed:            true,
+					},
+					"store_results": schema.BoolAttribute{
+						MarkdownDescription: "Whether the results of processing queued requests are stored",
+						Computed:            true,
+					},
+				},
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *WebhookDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WebhookDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_webhook", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data WebhookDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	// Get the webhook from the API
+	webhook, err := d.client.GetWebhook(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook, got error: %s", err))
+		return
+	}
+
+	// Map API response to Terraform state
+	data.Id = types.StringValue(webhook.ID.String())
+	data.Name = types.StringValue(webhook.Name)
+	data.Active = types.BoolValue(webhook.Active)
+
+	if webhook.URL != "" {
+		data.URL = types.StringValue(webhook.URL)
+	} else {
+		data.URL = types.StringNull()
+	}
+
+	if webhook.Email != "" {
+		data.Email = types.StringValue(webhook.Email)
+	} else {
+		data.Email = types.StringNull()
+	}
+
+	if webhook.Type != "" {
+		data.Type = types.StringValue(webhook.Type)
+	} else {
+		data.Type = types.StringNull()
+	}
+
+	if webhook.Status != "" {
+		data.Status = types.StringValue(webhook.Status)
+	} else {
+		data.Status = types.StringNull()
+	}
+
+	if webhook.TeamID != "" {
+		data.TeamId = types.StringValue(webhook.TeamID.String())
+	} else {
+		data.TeamId = types.StringNull()
+	}
+
+	if webhook.DataStructureID != "" {
+		data.DataStructureId = types.StringValue(webhook.DataStructureID.String())
+	} else {
+		data.DataStructureId = types.StringNull()
+	}
+
+	data.LearningMode = types.BoolValue(webhook.Learning)
+
+	data.IPRestrictions = sortedStringListValue(webhook.IPRestrictions)
+	data.Response = webhookReplyModel(webhook.Response)
+	data.Settings = webhookSettingsModel(webhook.Settings)
+	data.Queue = webhookQueueModel(webhook.Queue)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a webhook data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
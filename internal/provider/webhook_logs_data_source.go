@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WebhookLogsDataSource{}
+
+func NewWebhookLogsDataSource() datasource.DataSource {
+	return &WebhookLogsDataSource{}
+}
+
+// WebhookLogsDataSource defines the data source implementation.
+type WebhookLogsDataSource struct {
+	client MakeAPI
+}
+
+// WebhookLogsDataSourceModel describes the data source data model.
+type WebhookLogsDataSourceModel struct {
+	WebhookId types.String `tfsdk:"webhook_id"`
+	Limit     types.Int64  `tfsdk:"limit"`
+	Logs      types.List   `tfsdk:"logs"`
+
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// webhookLogAttrTypes are the attribute types of an entry in the logs list
+var webhookLogAttrTypes = map[string]attr.Type{
+	"id":           types.StringType,
+	"status":       types.StringType,
+	"size_bytes":   types.Int64Type,
+	"received_at":  types.StringType,
+	"processed_at": types.StringType,
+}
+
+func (d *WebhookLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_logs"
+}
+
+func (d *WebhookLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Recent deliveries to a Make.com webhook, e.g. to verify that a webhook receives data " +
+			"after it was created or its URL was rotated",
+
+		Attributes: map[string]schema.Attribute{
+			"webhook_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the webhook",
+				Required:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of deliveries to return, taking the newest deliveries first",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"logs": schema.ListNestedAttribute{
+				MarkdownDescription: "Deliveries to the webhook, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Delivery identifier",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the delivery, e.g. `accepted`, `processed` or `rejected`",
+							Computed:            true,
+						},
+						"size_bytes": schema.Int64Attribute{
+							MarkdownDescription: "Size of the delivered payload in bytes",
+							Computed:            true,
+						},
+						"received_at": schema.StringAttribute{
+							MarkdownDescription: "Time the delivery was received",
+							Computed:            true,
+						},
+						"processed_at": schema.StringAttribute{
+							MarkdownDescription: "Time a scenario processed the delivery, null while it is queued",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"auth_profile": authProfileDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *WebhookLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(MakeAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WebhookLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer recoverPanic(ctx, "make_webhook_logs", "read", &req.Config, &resp.Diagnostics)
+	defer d.client.AddQuotaWarning(&resp.Diagnostics)
+
+	var data WebhookLogsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+
+	logs, err := d.client.ListWebhookLogs(ctx, data.WebhookId.ValueString(), int(data.Limit.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook logs, got error: %s", err))
+		return
+	}
+
+	// Map response to Terraform state
+	logValues := make([]attr.Value, 0, len(logs))
+	for _, log := range logs {
+		logValues = append(logValues, types.ObjectValueMust(webhookLogAttrTypes, map[string]attr.Value{
+			"id":           types.StringValue(log.ID.String()),
+			"status":       types.StringValue(log.Status),
+			"size_bytes":   types.Int64Value(log.SizeBytes),
+			"received_at":  types.StringValue(log.ReceivedAt),
+			"processed_at": optionalString(log.ProcessedAt),
+		}))
+	}
+
+	data.Logs = types.ListValueMust(types.ObjectType{AttrTypes: webhookLogAttrTypes}, logValues)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a webhook logs data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -2,20 +2,46 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rockminster/terraform-provider-make/pkg/makeapi"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &WebhookResource{}
 var _ resource.ResourceWithImportState = &WebhookResource{}
+var _ resource.ResourceWithConfigValidators = &WebhookResource{}
+var _ resource.ResourceWithValidateConfig = &WebhookResource{}
+var _ resource.ResourceWithModifyPlan = &WebhookResource{}
+var _ resource.ResourceWithUpgradeState = &WebhookResource{}
+var _ resource.ResourceWithIdentity = &WebhookResource{}
+
+// Webhook types supported by Make
+const (
+	webhookTypeGateway  = "gateway-webhook"
+	webhookTypeMailhook = "gateway-mailhook"
+)
 
 func NewWebhookResource() resource.Resource {
 	return &WebhookResource{}
@@ -23,17 +49,52 @@ func NewWebhookResource() resource.Resource {
 
 // WebhookResource defines the resource implementation.
 type WebhookResource struct {
-	client *MakeAPIClient
+	client MakeAPI
 }
 
 // WebhookResourceModel describes the resource data model.
 type WebhookResourceModel struct {
-	Id       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	URL      types.String `tfsdk:"url"`
-	TeamId   types.String `tfsdk:"team_id"`
-	Active   types.Bool   `tfsdk:"active"`
-	Settings types.Map    `tfsdk:"settings"`
+	Id       types.String          `tfsdk:"id"`
+	Name     types.String          `tfsdk:"name"`
+	URL      types.String          `tfsdk:"url"`
+	Email    types.String          `tfsdk:"email"`
+	Type     types.String          `tfsdk:"type"`
+	TeamId   types.String          `tfsdk:"team_id"`
+	Active   types.Bool            `tfsdk:"active"`
+	Settings *WebhookSettingsModel `tfsdk:"settings"`
+	Queue    *WebhookQueueModel    `tfsdk:"queue"`
+
+	DataStructureId types.String `tfsdk:"data_structure_id"`
+	LearningMode    types.Bool   `tfsdk:"learning_mode"`
+	IPRestrictions  types.List   `tfsdk:"ip_restrictions"`
+
+	Response     *WebhookReplyModel `tfsdk:"response"`
+	RotateURL    types.String       `tfsdk:"rotate_url"`
+	ForceDestroy types.Bool         `tfsdk:"force_destroy"`
+
+	AuthProfile types.String   `tfsdk:"auth_profile"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// WebhookSettingsModel describes the advanced settings data model.
+type WebhookSettingsModel struct {
+	Method    types.Bool `tfsdk:"method"`
+	Headers   types.Bool `tfsdk:"headers"`
+	Stringify types.Bool `tfsdk:"stringify"`
+}
+
+// WebhookReplyModel describes the custom response data model.
+type WebhookReplyModel struct {
+	StatusCode types.Int64  `tfsdk:"status_code"`
+	Body       types.String `tfsdk:"body"`
+	Headers    types.Map    `tfsdk:"headers"`
+}
+
+// WebhookQueueModel describes the queue options data model.
+type WebhookQueueModel struct {
+	MaxSize      types.Int64 `tfsdk:"max_size"`
+	StopOnError  types.Bool  `tfsdk:"stop_on_error"`
+	StoreResults types.Bool  `tfsdk:"store_results"`
 }
 
 func (r *WebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -45,6 +106,8 @@ func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Make.com webhook resource",
 
+		Version: 1,
+
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -56,40 +119,269 @@ func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the webhook",
 				Required:            true,
+				Validators:          nameValidators(),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the webhook, either `" + webhookTypeGateway + "` for HTTP webhooks or `" +
+					webhookTypeMailhook + "` for mailhooks. Defaults to `" + webhookTypeGateway + "`. " +
+					"Changing the type forces a new webhook to be created.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(webhookTypeGateway),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(webhookTypeGateway, webhookTypeMailhook),
+				},
 			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "URL endpoint for the webhook",
+				MarkdownDescription: "URL endpoint for the webhook, set when `type` is `" + webhookTypeGateway + "`",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_url": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary value, e.g. a date or a counter, whose change regenerates the URL (or the " +
+					"email address of a mailhook) of the webhook, invalidating the previous one. Use it to rotate webhook " +
+					"addresses as you would rotate credentials. Setting it for the first time keeps the current address.",
+				Optional: true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the mailhook, set when `type` is `" + webhookTypeMailhook + "`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"team_id": schema.StringAttribute{
-				MarkdownDescription: "Team ID where the webhook belongs",
-				Optional:            true,
+				MarkdownDescription: "Team ID where the webhook belongs. Defaults to the provider `default_team_id`. " +
+					"Changing the team forces a new webhook to be created.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					requiresReplaceOwner("Make cannot move a webhook to another team, so changing the team replaces it."),
+				},
 			},
 			"active": schema.BoolAttribute{
-				MarkdownDescription: "Whether the webhook is active",
+				MarkdownDescription: "Whether the webhook is active. Changing only this attribute enables or disables the webhook " +
+					"through the dedicated endpoints, leaving the rest of its configuration untouched.",
+				Optional: true,
+			},
+			"settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Advanced settings controlling which parts of an incoming request reach the scenario",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"method": schema.BoolAttribute{
+						MarkdownDescription: "Whether the HTTP method of the request is passed to the scenario. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"headers": schema.BoolAttribute{
+						MarkdownDescription: "Whether the headers of the request are passed to the scenario. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"stringify": schema.BoolAttribute{
+						MarkdownDescription: "Whether a JSON payload is passed to the scenario as a string instead of being " +
+							"parsed, e.g. to verify its signature. Defaults to `false`.",
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+				},
+			},
+			"queue": schema.SingleNestedAttribute{
+				MarkdownDescription: "Options of the queue keeping incoming requests until the scenario processes them",
 				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_size": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of requests in the queue; further requests are rejected. " +
+							"Only tracked for drift when configured.",
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"stop_on_error": schema.BoolAttribute{
+						MarkdownDescription: "Whether processing of the queue stops when the scenario fails to process a " +
+							"request, keeping the following ones queued. Defaults to `false`.",
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"store_results": schema.BoolAttribute{
+						MarkdownDescription: "Whether the results of processing queued requests are stored. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
 			},
-			"settings": schema.MapAttribute{
-				MarkdownDescription: "Advanced settings for the webhook",
+			"data_structure_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the data structure incoming payloads are validated against",
 				Optional:            true,
-				ElementType:         types.StringType,
+			},
+			"learning_mode": schema.BoolAttribute{
+				MarkdownDescription: "Whether the webhook is in \"determine data structure\" learning mode. Make leaves " +
+					"learning mode on its own once a sample payload has been received; this is not reported as drift. " +
+					"Set to `false` and back to `true` to learn the structure again.",
+				Optional: true,
+			},
+			"ip_restrictions": schema.ListAttribute{
+				MarkdownDescription: "IP addresses and CIDR ranges allowed to call the webhook. Requests from any other " +
+					"source are rejected. Leave unset to accept requests from anywhere.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(ipOrCIDR()),
+				},
+			},
+			"response": schema.SingleNestedAttribute{
+				MarkdownDescription: "Custom response the webhook replies with once it accepted a request, e.g. to " +
+					"answer synchronous callers. Only applies to HTTP webhooks. Leave unset for the default `Accepted` reply.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"status_code": schema.Int64Attribute{
+						MarkdownDescription: "HTTP status code of the response",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(100, 599),
+						},
+					},
+					"body": schema.StringAttribute{
+						MarkdownDescription: "Body of the response",
+						Optional:            true,
+					},
+					"headers": schema.MapAttribute{
+						MarkdownDescription: "Headers of the response",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(stringvalidator.RegexMatches(headerNamePattern, "must be a valid HTTP header name")),
+						},
+					},
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the webhook while scenarios use it first stops the scenarios it " +
+					"triggers and removes it as the target of their execution logs. When `false`, destroying a webhook " +
+					"still in use fails naming the scenarios. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"auth_profile": authProfileResourceAttribute(),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *WebhookResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 held settings as a map of strings
+		0: upgradeStateJSON(upgradeWebhookSettings),
+	}
+}
+
+func (r *WebhookResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Webhook identifier",
+				RequiredForImport: true,
+			},
+			"team_id": identityschema.StringAttribute{
+				Description:       "Team ID where the webhook belongs",
+				OptionalForImport: true,
 			},
 		},
 	}
 }
 
+func (r *WebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var webhookType types.String
+	var response types.Object
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &webhookType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("response"), &response)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Mailhooks receive emails, which are not replied to
+	if webhookType.ValueString() == webhookTypeMailhook && !response.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("response"),
+			"Response Configured for a Mailhook",
+			"The response attribute only applies to HTTP webhooks. Remove it or set type to "+webhookTypeGateway+".",
+		)
+	}
+}
+
+func (r *WebhookResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		referenceFormat(
+			path.Root("team_id"),
+			path.Root("data_structure_id"),
+		),
+	}
+}
+
+func (r *WebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.PlanDefaultIDs(ctx, req, resp, defaultID{Path: path.Root("team_id")})
+
+	r.client.CheckReferences(ctx, resp.Plan, &resp.Diagnostics,
+		reference{Path: path.Root("team_id"), Endpoint: "v2/teams/%s"},
+		reference{Path: path.Root("data_structure_id"), Endpoint: "v2/data-structures/%s"},
+	)
+
+	// The address is kept from state by UseStateForUnknown unless the update
+	// actually regenerates it
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state WebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if webhookRotatesURL(plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("url"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("email"), types.StringUnknown())...)
+	}
+}
+
 func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
+		r.client = unconfiguredClient
 		return
 	}
 
-	client, ok := req.ProviderData.(*MakeAPIClient)
+	client, ok := req.ProviderData.(MakeAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *MakeAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected MakeAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -99,6 +391,10 @@ func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer recoverPanic(ctx, "make_webhook", "create", &req.Plan, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data WebhookResourceModel
 
 	// Read Terraform plan data into the model
@@ -108,47 +404,86 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	// Prepare the API request
-	apiReq := WebhookRequest{
-		Name:   data.Name.ValueString(),
-		Active: data.Active.ValueBool(),
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.TeamId.IsNull() {
-		apiReq.TeamID = data.TeamId.ValueString()
-	}
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, createTimeout)
+	defer cancel()
 
-	if !data.Settings.IsNull() {
-		var settingsMap map[string]string
-		resp.Diagnostics.Append(data.Settings.ElementsAs(ctx, &settingsMap, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		apiReq.Settings = make(map[string]interface{}, len(settingsMap))
-		for k, v := range settingsMap {
-			apiReq.Settings[k] = v
-		}
+	// Prepare the API request
+	apiReq, diags := webhookRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Create the webhook via API
-	webhook, err := r.client.CreateWebhook(ctx, apiReq)
+	createCtx, etags := makeapi.WithETagRecorder(ctx)
+	webhook, err := r.client.CreateWebhook(createCtx, apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook, got error: %s", err))
 		return
 	}
 
+	version := objectVersion{ETag: etags.ETag()}
+
+	if !data.LearningMode.IsNull() && data.LearningMode.ValueBool() != webhook.Learning {
+		if err := r.client.SetWebhookLearning(ctx, webhook.ID.String(), data.LearningMode.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set learning mode of webhook, got error: %s", err))
+			return
+		}
+
+		// The webhook changed since its ETag was recorded
+		version = objectVersion{}
+	}
+
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, version)...)
+
 	// Map response to Terraform state
-	data.Id = types.StringValue(webhook.ID)
+	data.Id = types.StringValue(webhook.ID.String())
 	data.Name = types.StringValue(webhook.Name)
-	data.URL = types.StringValue(webhook.URL)
 	data.Active = types.BoolValue(webhook.Active)
 
+	if webhook.Type != "" {
+		data.Type = types.StringValue(webhook.Type)
+	}
+
+	// Webhooks are addressed by URL and mailhooks by email address
+	if webhook.URL != "" {
+		data.URL = types.StringValue(webhook.URL)
+	} else {
+		data.URL = types.StringNull()
+	}
+
+	if webhook.Email != "" {
+		data.Email = types.StringValue(webhook.Email)
+	} else {
+		data.Email = types.StringNull()
+	}
+
 	if webhook.TeamID != "" {
-		data.TeamId = types.StringValue(webhook.TeamID)
+		data.TeamId = types.StringValue(webhook.TeamID.String())
+	}
+
+	if webhook.DataStructureID != "" {
+		data.DataStructureId = types.StringValue(webhook.DataStructureID.String())
+	}
+
+	// Make does not preserve the order of IP restrictions
+	data.IPRestrictions = stringListValueLike(ctx, data.IPRestrictions, webhook.IPRestrictions)
+	data.Response = webhookReplyModel(webhook.Response)
+
+	if webhook.Settings != nil {
+		data.Settings = webhookSettingsModelLike(data.Settings, webhook.Settings)
 	}
 
-	if len(webhook.Settings) > 0 {
-		data.Settings = types.MapValueMust(types.StringType, convertSettingsToStringMap(webhook.Settings))
+	if webhook.Queue != nil {
+		data.Queue = webhookQueueModelLike(data.Queue, webhook.Queue)
 	}
 
 	// Write logs using the tflog package
@@ -159,6 +494,10 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer recoverPanic(ctx, "make_webhook", "read", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data WebhookResourceModel
 
 	// Read Terraform prior state data into the model
@@ -168,29 +507,67 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the webhook from the API
+	ctx, etags := makeapi.WithETagRecorder(ctx)
 	webhook, err := r.client.GetWebhook(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook, got error: %s", err))
 		return
 	}
 
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, objectVersion{ETag: etags.ETag()})...)
+
 	// Map API response to Terraform state
-	data.Id = types.StringValue(webhook.ID)
+	data.Id = types.StringValue(webhook.ID.String())
 	data.Name = types.StringValue(webhook.Name)
-	data.URL = types.StringValue(webhook.URL)
 	data.Active = types.BoolValue(webhook.Active)
 
-	if webhook.TeamID != "" {
-		data.TeamId = types.StringValue(webhook.TeamID)
+	if webhook.Type != "" {
+		data.Type = types.StringValue(webhook.Type)
+	}
+
+	// Webhooks are addressed by URL and mailhooks by email address
+	if webhook.URL != "" {
+		data.URL = types.StringValue(webhook.URL)
 	} else {
-		data.TeamId = types.StringNull()
+		data.URL = types.StringNull()
+	}
+
+	if webhook.Email != "" {
+		data.Email = types.StringValue(webhook.Email)
+	} else {
+		data.Email = types.StringNull()
 	}
 
-	if len(webhook.Settings) > 0 {
-		data.Settings = types.MapValueMust(types.StringType, convertSettingsToStringMap(webhook.Settings))
+	if webhook.TeamID != "" {
+		data.TeamId = types.StringValue(webhook.TeamID.String())
+	}
+
+	if webhook.DataStructureID != "" {
+		data.DataStructureId = types.StringValue(webhook.DataStructureID.String())
 	} else {
-		data.Settings = types.MapNull(types.StringType)
+		data.DataStructureId = types.StringNull()
+	}
+
+	// Make does not preserve the order of IP restrictions
+	data.IPRestrictions = stringListValueLike(ctx, data.IPRestrictions, webhook.IPRestrictions)
+	data.Response = webhookReplyModel(webhook.Response)
+	data.Settings = webhookSettingsModelLike(data.Settings, webhook.Settings)
+	data.Queue = webhookQueueModelLike(data.Queue, webhook.Queue)
+
+	// Imported webhooks have no force_destroy value yet
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
 	}
 
 	// Save updated data into Terraform state
@@ -198,6 +575,10 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer recoverPanic(ctx, "make_webhook", "update", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data WebhookResourceModel
 
 	// Read Terraform plan data into the model
@@ -207,58 +588,144 @@ func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	// Prepare the API request
-	apiReq := WebhookRequest{
-		Name:   data.Name.ValueString(),
-		Active: data.Active.ValueBool(),
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.TeamId.IsNull() {
-		apiReq.TeamID = data.TeamId.ValueString()
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state WebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Settings.IsNull() {
-		var settingsMap map[string]string
-		resp.Diagnostics.Append(data.Settings.ElementsAs(ctx, &settingsMap, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		apiReq.Settings = make(map[string]interface{}, len(settingsMap))
-		for k, v := range settingsMap {
-			apiReq.Settings[k] = v
-		}
+	// Prepare the API request, which only sends the changed fields
+	apiReq, diags := webhookRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	priorReq, diags := webhookRequest(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Update the webhook via API
-	webhook, err := r.client.UpdateWebhook(ctx, data.Id.ValueString(), apiReq)
+	// Refuse to overwrite changes made since Terraform last read the webhook
+	version, diags := getObjectVersion(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Enabling or disabling a webhook goes through the dedicated endpoints,
+	// which leave the rest of its configuration untouched. Only these first
+	// changes are conditional, as the ones below change the ETag.
+	updateCtx, etags := makeapi.WithETagRecorder(makeapi.WithIfMatch(ctx, version.ETag))
+	var webhook *makeapi.WebhookResponse
+	var err error
+	if webhookOnlyActiveChanged(data, state) {
+		err = r.client.SetWebhookEnabled(makeapi.WithIfMatch(ctx, version.ETag), data.Id.ValueString(), data.Active.ValueBool())
+		if err == nil {
+			webhook, err = r.client.GetWebhook(updateCtx, data.Id.ValueString())
+		}
+	} else {
+		webhook, err = r.client.UpdateWebhook(updateCtx, data.Id.ValueString(), priorReq, apiReq)
+	}
 	if err != nil {
+		var conflict *makeapi.ConflictError
+		if errors.As(err, &conflict) {
+			addObjectChangedError(&resp.Diagnostics, "webhook", data.Id.ValueString())
+			return
+		}
+
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook, got error: %s", err))
 		return
 	}
 
+	version = objectVersion{ETag: etags.ETag()}
+
+	// Learning mode is only toggled when the configured value changes, since
+	// Make turns it off by itself once a data structure has been determined
+	if !data.LearningMode.IsNull() && !data.LearningMode.Equal(state.LearningMode) {
+		if err := r.client.SetWebhookLearning(ctx, webhook.ID.String(), data.LearningMode.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set learning mode of webhook, got error: %s", err))
+			return
+		}
+
+		// The webhook changed since its ETag was recorded
+		version = objectVersion{}
+	}
+
+	// Changing rotate_url regenerates the address of the webhook
+	if webhookRotatesURL(data, state) {
+		rotateCtx, rotateETags := makeapi.WithETagRecorder(ctx)
+		webhook, err = r.client.RotateWebhookURL(rotateCtx, webhook.ID.String())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate webhook URL, got error: %s", err))
+			return
+		}
+
+		version = objectVersion{ETag: rotateETags.ETag()}
+
+		tflog.Info(ctx, "rotated webhook URL", map[string]interface{}{"id": webhook.ID.String()})
+	}
+
+	resp.Diagnostics.Append(setObjectVersion(ctx, resp.Private, version)...)
+
 	// Map response to Terraform state
-	data.Id = types.StringValue(webhook.ID)
+	data.Id = types.StringValue(webhook.ID.String())
 	data.Name = types.StringValue(webhook.Name)
-	data.URL = types.StringValue(webhook.URL)
 	data.Active = types.BoolValue(webhook.Active)
 
+	if webhook.Type != "" {
+		data.Type = types.StringValue(webhook.Type)
+	}
+
+	// Webhooks are addressed by URL and mailhooks by email address
+	if webhook.URL != "" {
+		data.URL = types.StringValue(webhook.URL)
+	} else {
+		data.URL = types.StringNull()
+	}
+
+	if webhook.Email != "" {
+		data.Email = types.StringValue(webhook.Email)
+	} else {
+		data.Email = types.StringNull()
+	}
+
 	if webhook.TeamID != "" {
-		data.TeamId = types.StringValue(webhook.TeamID)
+		data.TeamId = types.StringValue(webhook.TeamID.String())
 	} else {
 		data.TeamId = types.StringNull()
 	}
 
-	if len(webhook.Settings) > 0 {
-		data.Settings = types.MapValueMust(types.StringType, convertSettingsToStringMap(webhook.Settings))
+	if webhook.DataStructureID != "" {
+		data.DataStructureId = types.StringValue(webhook.DataStructureID.String())
 	} else {
-		data.Settings = types.MapNull(types.StringType)
+		data.DataStructureId = types.StringNull()
 	}
 
+	// Make does not preserve the order of IP restrictions
+	data.IPRestrictions = stringListValueLike(ctx, data.IPRestrictions, webhook.IPRestrictions)
+	data.Response = webhookReplyModel(webhook.Response)
+	data.Settings = webhookSettingsModelLike(data.Settings, webhook.Settings)
+	data.Queue = webhookQueueModelLike(data.Queue, webhook.Queue)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer recoverPanic(ctx, "make_webhook", "delete", &req.State, &resp.Diagnostics)
+	defer r.client.AddQuotaWarning(&resp.Diagnostics)
+
 	var data WebhookResourceModel
 
 	// Read Terraform prior state data into the model
@@ -268,8 +735,47 @@ func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = withAuthProfile(ctx, data.AuthProfile)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := makeapi.WithOperationTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Scenarios triggered by the webhook or exporting their execution logs to
+	// it keep Make from deleting it
+	id := data.Id.ValueString()
+	forceDestroy := data.ForceDestroy.ValueBool()
+
+	if teamID := data.TeamId.ValueString(); teamID != "" {
+		scenarios, err := webhookScenarios(ctx, r.client, teamID, id)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to Check Webhook Usage",
+				fmt.Sprintf("Could not list the scenarios of team %s using the webhook, got error: %s", teamID, err),
+			)
+		}
+
+		if len(scenarios) > 0 && !forceDestroy {
+			resp.Diagnostics.AddError(
+				"Webhook In Use",
+				fmt.Sprintf("The webhook is used by the scenarios %s. Remove it from these scenarios first, or set "+
+					"force_destroy to true to stop them and detach the webhook before destroying it.", scenarioList(scenarios)),
+			)
+			return
+		}
+
+		if err := detachWebhook(ctx, r.client, id, scenarios); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach webhook from its scenarios, got error: %s", err))
+			return
+		}
+	}
+
 	// Delete the webhook via API
-	err := r.client.DeleteWebhook(ctx, data.Id.ValueString())
+	err := r.client.DeleteWebhook(ctx, id, forceDestroy)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook, got error: %s", err))
 		return
@@ -277,6 +783,314 @@ func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	req.ID = identityImportID(req, &resp.Diagnostics, "team_id", "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// name=<name> imports the webhook of that name
+	id, err := r.client.ResolveImportName(ctx, req.ID, "webhook", importCandidates(MakeAPI.ListWebhooks, func(webhook makeapi.WebhookResponse) importCandidate {
+		return importCandidate{ID: webhook.ID.String(), Name: webhook.Name}
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Import by Name", err.Error())
+		return
+	}
+
+	// The ID may be prefixed by the team ID, e.g. <team_id>/<id>
+	importScopedID(ctx, id, path.Root("team_id"), resp)
+}
+
+// webhookScenarios returns the scenarios of a team triggered by the webhook of
+// the given ID or exporting their execution logs to it
+func webhookScenarios(ctx context.Context, client MakeAPI, teamID, id string) ([]makeapi.ScenarioResponse, error) {
+	scenarios, err := client.ListScenarios(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var using []makeapi.ScenarioResponse
+	for _, scenario := range scenarios {
+		exports := scenario.ExportExecutionsTo != nil && scenario.ExportExecutionsTo.Type == "hook" &&
+			scenario.ExportExecutionsTo.ID.String() == id
+		if scenario.HookID.String() == id || exports {
+			using = append(using, scenario)
+		}
+	}
+
+	return using, nil
+}
+
+// detachWebhook stops the scenarios triggered by the webhook of the given ID
+// and stops exporting the execution logs of the others to it
+func detachWebhook(ctx context.Context, client MakeAPI, id string, scenarios []makeapi.ScenarioResponse) error {
+	for _, scenario := range scenarios {
+		if scenario.HookID.String() == id && scenario.Active {
+			tflog.Info(ctx, "stopping scenario triggered by webhook", map[string]interface{}{"id": scenario.ID.String(), "webhook_id": id})
+
+			if _, err := client.StopScenario(ctx, scenario.ID.String()); err != nil {
+				return fmt.Errorf("failed to stop scenario %s: %w", scenario.ID, err)
+			}
+		}
+
+		if export := scenario.ExportExecutionsTo; export != nil && export.Type == "hook" && export.ID.String() == id {
+			prior := makeapi.ScenarioRequest{ExportExecutionsTo: export}
+			if _, err := client.UpdateScenario(ctx, scenario.ID.String(), prior, makeapi.ScenarioRequest{}); err != nil {
+				return fmt.Errorf("failed to stop exporting the execution logs of scenario %s: %w", scenario.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// scenarioList formats scenarios for diagnostics, e.g. "Orders" (ID 12)
+func scenarioList(scenarios []makeapi.ScenarioResponse) string {
+	names := make([]string, len(scenarios))
+	for i, scenario := range scenarios {
+		names[i] = fmt.Sprintf("%q (ID %s)", scenario.Name, scenario.ID)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// webhookOnlyActiveChanged reports whether active is the only configurable
+// attribute that differs between the plan and the prior state
+func webhookOnlyActiveChanged(plan, state WebhookResourceModel) bool {
+	if plan.Active.Equal(state.Active) {
+		return false
+	}
+
+	return plan.Name.Equal(state.Name) &&
+		plan.Type.Equal(state.Type) &&
+		plan.TeamId.Equal(state.TeamId) &&
+		webhookSettingsEqual(plan.Settings, state.Settings) &&
+		webhookQueueEqual(plan.Queue, state.Queue) &&
+		plan.DataStructureId.Equal(state.DataStructureId) &&
+		plan.IPRestrictions.Equal(state.IPRestrictions) &&
+		plan.RotateURL.Equal(state.RotateURL) &&
+		webhookReplyEqual(plan.Response, state.Response)
+}
+
+// webhookRotatesURL reports whether the plan changes rotate_url and so
+// regenerates the address of the webhook. Setting rotate_url for the first
+// time does not, so that adopting it keeps the address in use.
+func webhookRotatesURL(plan, state WebhookResourceModel) bool {
+	return !state.RotateURL.IsNull() && !plan.RotateURL.IsNull() && !plan.RotateURL.Equal(state.RotateURL)
+}
+
+// webhookSettingsEqual reports whether two advanced settings models are equal
+func webhookSettingsEqual(a, b *WebhookSettingsModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Method.Equal(b.Method) && a.Headers.Equal(b.Headers) && a.Stringify.Equal(b.Stringify)
+}
+
+// webhookSettingsModel maps the advanced settings of a webhook to their
+// Terraform model
+func webhookSettingsModel(settings *makeapi.WebhookSettings) *WebhookSettingsModel {
+	if settings == nil {
+		return nil
+	}
+
+	return &WebhookSettingsModel{
+		Method:    types.BoolValue(settings.Method),
+		Headers:   types.BoolValue(settings.Headers),
+		Stringify: types.BoolValue(settings.Stringify),
+	}
+}
+
+// webhookSettingsModelLike maps the advanced settings of a webhook like
+// webhookSettingsModel, except that settings left at their defaults stay unset
+// when prior is, so that webhooks configured without settings show no drift
+func webhookSettingsModelLike(prior *WebhookSettingsModel, settings *makeapi.WebhookSettings) *WebhookSettingsModel {
+	if prior == nil && settings != nil && *settings == (makeapi.WebhookSettings{}) {
+		return nil
+	}
+
+	return webhookSettingsModel(settings)
+}
+
+// upgradeWebhookSettings converts the settings of a version 0 webhook state,
+// a map of strings such as {"headers" = "true"}, to the settings object.
+// Unknown keys, which Make ignored, are dropped.
+func upgradeWebhookSettings(state map[string]interface{}) error {
+	prior, ok := state["settings"].(map[string]interface{})
+	if !ok {
+		state["settings"] = nil
+		return nil
+	}
+
+	settings := map[string]interface{}{"method": false, "headers": false, "stringify": false}
+	for key := range settings {
+		value, ok := prior[key].(string)
+		if !ok {
+			continue
+		}
+
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("webhook setting %s holds %q, which is not a boolean", key, value)
+		}
+		settings[key] = enabled
+	}
+
+	state["settings"] = settings
+	return nil
+}
+
+// webhookReplyEqual reports whether two custom response models are equal
+func webhookReplyEqual(a, b *WebhookReplyModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.StatusCode.Equal(b.StatusCode) && a.Body.Equal(b.Body) && a.Headers.Equal(b.Headers)
+}
+
+// webhookReplyModel maps the custom response of a webhook to its Terraform model
+func webhookReplyModel(reply *makeapi.WebhookReply) *WebhookReplyModel {
+	if reply == nil {
+		return nil
+	}
+
+	model := &WebhookReplyModel{
+		StatusCode: types.Int64Value(reply.StatusCode),
+		Body:       types.StringNull(),
+		Headers:    types.MapNull(types.StringType),
+	}
+
+	if reply.Body != "" {
+		model.Body = types.StringValue(reply.Body)
+	}
+
+	if len(reply.Headers) > 0 {
+		headers := make(map[string]attr.Value, len(reply.Headers))
+		for k, v := range reply.Headers {
+			headers[k] = types.StringValue(v)
+		}
+		model.Headers = types.MapValueMust(types.StringType, headers)
+	}
+
+	return model
+}
+
+// webhookRequest returns the API request for a webhook model
+func webhookRequest(ctx context.Context, data WebhookResourceModel) (makeapi.WebhookRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiReq := makeapi.WebhookRequest{
+		Name:   data.Name.ValueString(),
+		Type:   data.Type.ValueString(),
+		Active: data.Active.ValueBool(),
+	}
+
+	if !data.TeamId.IsNull() {
+		apiReq.TeamID = data.TeamId.ValueString()
+	}
+
+	if !data.DataStructureId.IsNull() {
+		apiReq.DataStructureID = data.DataStructureId.ValueStringPointer()
+	}
+
+	if !data.IPRestrictions.IsNull() {
+		diags.Append(data.IPRestrictions.ElementsAs(ctx, &apiReq.IPRestrictions, false)...)
+		if diags.HasError() {
+			return apiReq, diags
+		}
+	}
+
+	if data.Response != nil {
+		apiReq.Response = &makeapi.WebhookReply{
+			StatusCode: data.Response.StatusCode.ValueInt64(),
+			Body:       data.Response.Body.ValueString(),
+		}
+
+		if !data.Response.Headers.IsNull() {
+			diags.Append(data.Response.Headers.ElementsAs(ctx, &apiReq.Response.Headers, false)...)
+			if diags.HasError() {
+				return apiReq, diags
+			}
+		}
+	}
+
+	if data.Settings != nil {
+		apiReq.Settings = &makeapi.WebhookSettings{
+			Method:    data.Settings.Method.ValueBool(),
+			Headers:   data.Settings.Headers.ValueBool(),
+			Stringify: data.Settings.Stringify.ValueBool(),
+		}
+	}
+
+	apiReq.Queue = webhookQueueRequest(data.Queue)
+
+	return apiReq, diags
+}
+
+// webhookQueueRequest returns the queue options of a webhook sent to the API
+func webhookQueueRequest(queue *WebhookQueueModel) *makeapi.WebhookQueue {
+	if queue == nil {
+		return nil
+	}
+
+	return &makeapi.WebhookQueue{
+		MaxSize:      queue.MaxSize.ValueInt64(),
+		StopOnError:  queue.StopOnError.ValueBool(),
+		StoreResults: queue.StoreResults.ValueBool(),
+	}
+}
+
+// webhookQueueEqual reports whether two queue options models are equal
+func webhookQueueEqual(a, b *WebhookQueueModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.MaxSize.Equal(b.MaxSize) && a.StopOnError.Equal(b.StopOnError) && a.StoreResults.Equal(b.StoreResults)
+}
+
+// webhookQueueModel maps the queue options of a webhook to their Terraform model
+func webhookQueueModel(queue *makeapi.WebhookQueue) *WebhookQueueModel {
+	if queue == nil {
+		return nil
+	}
+
+	model := &WebhookQueueModel{
+		MaxSize:      types.Int64Null(),
+		StopOnError:  types.BoolValue(queue.StopOnError),
+		StoreResults: types.BoolValue(queue.StoreResults),
+	}
+
+	if queue.MaxSize != 0 {
+		model.MaxSize = types.Int64Value(queue.MaxSize)
+	}
+
+	return model
+}
+
+// webhookQueueModelLike maps the queue options of a webhook like
+// webhookQueueModel, except that options left at their defaults stay unset
+// when prior is, and that max_size is only tracked when prior sets it
+func webhookQueueModelLike(prior *WebhookQueueModel, queue *makeapi.WebhookQueue) *WebhookQueueModel {
+	if queue == nil {
+		return nil
+	}
+
+	if prior == nil {
+		if !queue.StopOnError && !queue.StoreResults {
+			return nil
+		}
+		prior = &WebhookQueueModel{MaxSize: types.Int64Null()}
+	}
+
+	model := webhookQueueModel(queue)
+	if prior.MaxSize.IsNull() {
+		model.MaxSize = types.Int64Null()
+	}
+
+	return model
 }
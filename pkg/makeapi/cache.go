@@ -0,0 +1,118 @@
+package makeapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// responseCacheSize and responseCacheMaxBody bound the memory of the
+// response cache: the number of responses kept and the size of each
+const (
+	responseCacheSize    = 1000
+	responseCacheMaxBody = 1 << 20
+)
+
+// ResponseCache keeps GET responses carrying an ETag for the lifetime of the
+// provider, so that refreshing many resources revalidates unchanged objects
+// with If-None-Match instead of downloading them again
+type ResponseCache struct {
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+
+	// order lists the keys from oldest to newest, for evicting the oldest
+	// response when the cache is full
+	order []string
+}
+
+// cachedResponse is a response kept by the ResponseCache
+type cachedResponse struct {
+	ETag   string
+	Header http.Header
+	Body   []byte
+}
+
+// NewResponseCache returns an empty response cache
+func NewResponseCache() *ResponseCache {
+	return &ResponseCache{entries: make(map[string]*cachedResponse)}
+}
+
+// responseCacheKey identifies a GET request by its URL and credentials, so
+// that auth profiles never see responses meant for other credentials
+func responseCacheKey(req *http.Request) string {
+	credentials := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(credentials[:]) + " " + req.URL.String()
+}
+
+// get returns the cached response to a request, nil if there is none
+func (c *ResponseCache) get(key string) *cachedResponse {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.entries[key]
+}
+
+// store caches a successful response with an ETag and returns it with its
+// body replaced by a copy, since caching reads the original body
+func (c *ResponseCache) store(key string, resp *http.Response) (*http.Response, error) {
+	etag := resp.Header.Get("ETag")
+	if c == nil || etag == "" || resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, responseCacheMaxBody+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+
+	// Bodies too large to cache are passed on with the unread rest
+	if len(body) > responseCacheMaxBody {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok {
+		if len(c.order) >= responseCacheSize {
+			delete(c.entries, c.order[0])
+			c.order = c.order[1:]
+		}
+		c.order = append(c.order, key)
+	}
+	c.entries[key] = &cachedResponse{ETag: etag, Header: resp.Header.Clone(), Body: body}
+
+	return resp, nil
+}
+
+// response rebuilds the response of a request revalidated with 304 Not
+// Modified from the cache
+func (r *cachedResponse) response(notModified *http.Response) *http.Response {
+	_ = notModified.Body.Close()
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         notModified.Proto,
+		ProtoMajor:    notModified.ProtoMajor,
+		ProtoMinor:    notModified.ProtoMinor,
+		Header:        r.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       notModified.Request,
+	}
+}
@@ -0,0 +1,3541 @@
+package makeapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Client is a client of the Make API. The zero value is not usable: BaseUrl,
+// HTTPClient and either ApiToken or OAuth must be set.
+type Client struct {
+	ApiToken   string
+	BaseUrl    string
+	HTTPClient *http.Client
+
+	// APIPathPrefix replaces the "v2" prefix of API endpoints, for deployments
+	// whose API layout differs from api.make.com. Empty for the default.
+	APIPathPrefix string
+
+	// CustomHeaders are added to every request, e.g. for gateways requiring
+	// tenant or tracing headers
+	CustomHeaders map[string]string
+
+	// UserAgent identifies the provider and its version to the API
+	UserAgent string
+
+	// MaxRetries is how often requests failing with a transient error are
+	// retried. Zero disables retries.
+	MaxRetries int
+
+	// Workers bounds how many requests a single call fans out to at once,
+	// e.g. to fetch the pages of a list. Zero or one fetches sequentially.
+	// Requests in flight stay bounded by Limiter across all calls.
+	Workers int
+
+	// OperationTimeout bounds each API call including its retries and the
+	// waits between them, so that a hung endpoint fails with a TimeoutError
+	// instead of stalling the caller. Zero leaves calls bounded only by their
+	// context.
+	OperationTimeout time.Duration
+
+	// Limiter bounds the requests in flight. It is shared by the clients of
+	// all auth profiles.
+	Limiter RequestLimiter
+
+	// Quota tracks the API quota reported by responses to warn once when it
+	// is nearly exhausted
+	Quota *QuotaMonitor
+
+	// Cache keeps GET responses with an ETag for revalidation. It is shared
+	// by the clients of all auth profiles, which it keeps apart.
+	Cache *ResponseCache
+
+	// OAuth obtains access tokens when authenticating with OAuth2 client
+	// credentials instead of ApiToken
+	OAuth *OAuthTokenSource
+
+	// Profiles holds the clients of the named auth profiles, selected per
+	// request with WithAuthProfile
+	Profiles map[string]*Client
+
+	// ValidateReferences enables plan-time existence checks of referenced objects
+	ValidateReferences bool
+
+	// DefaultTeamID and DefaultOrganizationID are used by resources that omit
+	// team_id or organization_id
+	DefaultTeamID         string
+	DefaultOrganizationID string
+}
+
+// ScenarioResponse represents a Make.com scenario from the API
+type ScenarioResponse struct {
+	ID                     ID                       `json:"id"`
+	Name                   string                   `json:"name"`
+	Description            string                   `json:"description,omitempty"`
+	Active                 bool                     `json:"is_active"`
+	TeamID                 ID                       `json:"team_id,omitempty"`
+	ExecutionRetentionDays int64                    `json:"execution_retention_days,omitempty"`
+	ExportExecutionsTo     *ScenarioExecutionExport `json:"export_executions_to,omitempty"`
+	Scheduling             *ScenarioScheduling      `json:"scheduling,omitempty"`
+	LastEdit               string                   `json:"lastEdit,omitempty"`
+
+	// HookID is the webhook triggering the scenario, if any
+	HookID ID `json:"hook_id,omitempty"`
+}
+
+// ScenarioRequest represents the request payload for creating/updating scenarios
+type ScenarioRequest struct {
+	Name                   string                   `json:"name"`
+	Description            string                   `json:"description,omitempty"`
+	Active                 bool                     `json:"is_active"`
+	TeamID                 string                   `json:"team_id,omitempty"`
+	ExecutionRetentionDays int64                    `json:"execution_retention_days,omitempty"`
+	ExportExecutionsTo     *ScenarioExecutionExport `json:"export_executions_to,omitempty"`
+	Scheduling             *ScenarioScheduling      `json:"scheduling,omitempty"`
+	Blueprint              string                   `json:"blueprint,omitempty"`
+}
+
+// ScenarioScheduling describes when a scenario runs
+type ScenarioScheduling struct {
+	Type     string `json:"type"`
+	Interval int64  `json:"interval,omitempty"`
+}
+
+// ScenarioExecutionExport describes where a scenario's execution logs are exported
+type ScenarioExecutionExport struct {
+	Type string `json:"type"`
+	ID   ID     `json:"id"`
+}
+
+// ScenarioExecution represents an execution of a Make.com scenario
+type ScenarioExecution struct {
+	ID        ID     `json:"id"`
+	Status    string `json:"status"`
+	StartedAt string `json:"started_at,omitempty"`
+}
+
+// ErrorResponse represents an error response from Make.com API
+type ErrorResponse struct {
+	Error   string `json:"error,omitempty"`
+	Message string `json:"message,omitempty"`
+	Code    int    `json:"code,omitempty"`
+	// Detail is used by the SCIM endpoints
+	Detail string `json:"detail,omitempty"`
+	// Suberrors describe the problems of individual fields of invalid requests
+	Suberrors []SuberrorResponse `json:"suberrors,omitempty"`
+}
+
+// SuberrorResponse represents a problem with a single field of a request
+type SuberrorResponse struct {
+	Message string `json:"message"`
+	Path    string `json:"path,omitempty"`
+}
+
+// MakeRequest performs a HTTP request to the Make.com API
+func (c *Client) MakeRequest(ctx context.Context, method, endpoint string, body interface{}) (*http.Response, error) {
+	var reqBody []byte
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = jsonData
+	}
+
+	return c.doRequest(ctx, method, endpoint, reqBody, "application/json")
+}
+
+// MakeMultipartRequest performs a HTTP request to the Make.com API uploading
+// content as the file of a multipart form
+func (c *Client) MakeMultipartRequest(ctx context.Context, method, endpoint, fieldName, fileName string, content []byte) (*http.Response, error) {
+	var reqBody bytes.Buffer
+	writer := multipart.NewWriter(&reqBody)
+
+	part, err := writer.CreateFormFile(fieldName, fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create multipart form: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, fmt.Errorf("failed to write multipart form: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write multipart form: %w", err)
+	}
+
+	return c.doRequest(ctx, method, endpoint, reqBody.Bytes(), writer.FormDataContentType())
+}
+
+// operationTimeoutKey is the context key of the timeout set by
+// WithOperationTimeout
+type operationTimeoutKey struct{}
+
+// WithOperationTimeout returns a context bounding all the API calls made with
+// it by the given timeout, in place of the OperationTimeout of the client
+// bounding each call, e.g. to apply the timeouts configured on a resource.
+// Calls exceeding it fail with a TimeoutError. A timeout of zero or less
+// returns ctx as it is, leaving each call bounded by the OperationTimeout.
+func WithOperationTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	ctx = context.WithValue(ctx, operationTimeoutKey{}, timeout)
+	return context.WithTimeoutCause(ctx, timeout, errOperationTimeout)
+}
+
+// doRequest sends a request with the given body and content type to the
+// Make.com API within the operation timeout, whose deadline lasts until the
+// response body is closed
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, reqBody []byte, contentType string) (*http.Response, error) {
+	// The timeout of the context bounds the whole operation already
+	timeout, bounded := ctx.Value(operationTimeoutKey{}).(time.Duration)
+	if !bounded {
+		timeout = c.OperationTimeout
+	}
+
+	cancel := context.CancelFunc(func() {})
+	if !bounded && timeout > 0 {
+		ctx, cancel = context.WithTimeoutCause(ctx, timeout, errOperationTimeout)
+	}
+
+	resp, err := c.sendRequest(ctx, method, endpoint, reqBody, contentType)
+	if err != nil {
+		cancel()
+		if errors.Is(context.Cause(ctx), errOperationTimeout) {
+			urlPath, _, _ := strings.Cut(endpoint, "?")
+			return nil, &TimeoutError{Timeout: timeout, Request: RequestInfo{Method: method, Path: urlPath}}
+		}
+		return nil, err
+	}
+
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// sendRequest performs a request. Requests failing with a transient error are
+// retried with exponential backoff up to MaxRetries times: requests that could
+// not connect and rate limited ones whatever their method, and requests of
+// idempotent methods failing with a server error.
+func (c *Client) sendRequest(ctx context.Context, method, endpoint string, reqBody []byte, contentType string) (*http.Response, error) {
+	// Use the credentials of the auth profile selected for the request
+	c, err := c.ProfileClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct the full URL
+	baseURL, err := url.Parse(c.BaseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+
+	// Endpoints may carry an already encoded query string
+	endpoint, query, _ := strings.Cut(endpoint, "?")
+	if rest, ok := strings.CutPrefix(endpoint, "v2/"); ok && c.APIPathPrefix != "" {
+		endpoint = path.Join(c.APIPathPrefix, rest)
+	}
+	baseURL.Path = path.Join(baseURL.Path, endpoint)
+	baseURL.RawQuery = query
+
+	for retry := 0; ; retry++ {
+		var body io.Reader
+		if reqBody != nil {
+			body = bytes.NewReader(reqBody)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, baseURL.String(), body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		// Set headers. Custom headers come first so that they cannot replace
+		// the ones the API relies on.
+		for name, value := range c.CustomHeaders {
+			req.Header.Set(name, value)
+		}
+		if c.OAuth != nil {
+			token, err := c.OAuth.Token(ctx)
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+		} else {
+			req.Header.Set("Authorization", "Token "+c.ApiToken)
+		}
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Accept", "application/json")
+		if etag, ok := ctx.Value(ifMatchKey{}).(string); ok && method != http.MethodGet {
+			req.Header.Set("If-Match", etag)
+		}
+
+		// Revalidate cached responses instead of downloading them again
+		var cacheKey string
+		var cached *cachedResponse
+		if method == http.MethodGet {
+			cacheKey = responseCacheKey(req)
+			if cached = c.Cache.get(cacheKey); cached != nil {
+				req.Header.Set("If-None-Match", cached.ETag)
+			}
+		}
+		if c.UserAgent != "" {
+			req.Header.Set("User-Agent", c.UserAgent)
+		}
+
+		// Perform the request, waiting while too many others are in flight
+		if err := c.Limiter.acquire(ctx); err != nil {
+			return nil, fmt.Errorf("failed to perform request: %w", err)
+		}
+		resp, err := c.HTTPClient.Do(req)
+		c.Limiter.release()
+		if err != nil {
+			if !isConnectError(err) || retry >= c.MaxRetries || ctx.Err() != nil {
+				return nil, fmt.Errorf("failed to perform request: %w", err)
+			}
+
+			delay := retryBackoff(retry)
+			tflog.Debug(ctx, "retrying Make API request", map[string]interface{}{
+				"method": method,
+				"url":    baseURL.String(),
+				"error":  err.Error(),
+				"retry":  retry + 1,
+				"delay":  delay.String(),
+			})
+
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("failed to perform request: %w", ctx.Err())
+			case <-time.After(delay):
+			}
+			continue
+		}
+
+		if quota, ok := parseRateLimit(resp.Header, time.Now()); ok {
+			logRateLimit(ctx, quota)
+			c.Quota.observe(quota)
+		}
+
+		if !isRetryableStatus(method, resp.StatusCode) || retry >= c.MaxRetries {
+			if method == http.MethodGet {
+				if resp.StatusCode == http.StatusNotModified && cached != nil {
+					resp = cached.response(resp)
+				} else if resp, err = c.Cache.store(cacheKey, resp); err != nil {
+					return nil, fmt.Errorf("failed to read response: %w", err)
+				}
+			}
+
+			if recorder, ok := ctx.Value(etagRecorderKey{}).(*ETagRecorder); ok && resp.StatusCode < 400 {
+				recorder.record(resp.Header.Get("ETag"))
+			}
+			return resp, nil
+		}
+
+		// Rate limited requests wait as long as the API asks them to
+		delay := retryBackoff(retry)
+		if wait, ok := retryAfter(resp, time.Now()); ok && resp.StatusCode == http.StatusTooManyRequests {
+			if wait > maxRetryAfter {
+				return resp, nil
+			}
+			delay = wait
+		}
+
+		_ = resp.Body.Close()
+
+		tflog.Debug(ctx, "retrying Make API request", map[string]interface{}{
+			"method": method,
+			"url":    baseURL.String(),
+			"status": resp.StatusCode,
+			"retry":  retry + 1,
+			"delay":  delay.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to perform request: %w", ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+}
+
+// HandleErrorResponse processes error responses from the API into an
+// AuthError, NotFoundError, ConflictError, RateLimitedError, ValidationError
+// or APIError naming the request and its request ID
+func (c *Client) HandleErrorResponse(resp *http.Response) error {
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		body = []byte(resp.Status)
+	}
+
+	// Bodies that are not JSON are reported as they are
+	var errorResp ErrorResponse
+	_ = json.Unmarshal(body, &errorResp)
+
+	apiMessage := errorResp.Message
+	if apiMessage == "" {
+		apiMessage = errorResp.Error
+	}
+	if apiMessage == "" {
+		apiMessage = errorResp.Detail
+	}
+
+	message := apiMessage
+	if message == "" {
+		message = string(body)
+	}
+
+	request := newRequestInfo(resp)
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		// Raw bodies of rejected credentials do not help, the scope does
+		return &AuthError{
+			StatusCode: resp.StatusCode,
+			Message:    apiMessage,
+			Scope:      requiredScope(request.Method, request.Path),
+			Request:    request,
+		}
+	case http.StatusPreconditionFailed:
+		return &ConflictError{StatusCode: resp.StatusCode, Message: message, Request: request}
+	case http.StatusNotFound:
+		return &NotFoundError{Message: fmt.Sprintf("API request failed with status %d: %s", resp.StatusCode, message), Request: request}
+	case http.StatusTooManyRequests:
+		wait, _ := retryAfter(resp, time.Now())
+		return &RateLimitedError{StatusCode: resp.StatusCode, Message: message, Request: request, RetryAfter: wait}
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		validationErr := &ValidationError{StatusCode: resp.StatusCode, Message: message, Request: request}
+		for _, suberror := range errorResp.Suberrors {
+			validationErr.Fields = append(validationErr.Fields, FieldError{Field: suberror.Path, Message: suberror.Message})
+		}
+		return validationErr
+	}
+
+	return &APIError{StatusCode: resp.StatusCode, Message: message, Request: request}
+}
+
+// ReferenceExists reports whether the object at the given API endpoint exists
+func (c *Client) ReferenceExists(ctx context.Context, endpoint string) (bool, error) {
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return false, nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return false, c.HandleErrorResponse(resp)
+	}
+
+	return true, nil
+}
+
+// listPageSize is the number of items requested per page by listAll
+const listPageSize = 100
+
+// listOptions adjusts how listAll reads a paginated list endpoint
+type listOptions struct {
+	// SortBy and SortDir ("asc" or "desc") order the items. Empty to keep
+	// the order of the API.
+	SortBy  string
+	SortDir string
+
+	// NotFound is the error reported when the endpoint responds with 404,
+	// e.g. because the parent object is missing. Empty to report the API
+	// error.
+	NotFound string
+}
+
+// listAll retrieves all items of a paginated list endpoint, following
+// pagination until a short page is returned. key is the property of the
+// response holding the items. Pages after the first are fetched by up to
+// Workers requests at once, which may read a few empty pages past the end.
+func listAll[T any](ctx context.Context, c *Client, endpoint, key string, options listOptions) ([]T, error) {
+	var items []T
+	for {
+		batch := 1
+		if len(items) > 0 {
+			batch = max(c.Workers, 1)
+		}
+
+		offsets := make([]int, batch)
+		for i := range offsets {
+			offsets[i] = len(items) + i*listPageSize
+		}
+
+		pages := make([][]T, batch)
+		err := ForEach(ctx, batch, offsets, func(ctx context.Context, i int, offset int) error {
+			page, err := listPage[T](ctx, c, endpoint, key, offset, options)
+			pages[i] = page
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, page := range pages {
+			items = append(items, page...)
+
+			if len(page) < listPageSize {
+				return items, nil
+			}
+		}
+	}
+}
+
+// listPage retrieves the page of a list endpoint starting at offset
+func listPage[T any](ctx context.Context, c *Client, endpoint, key string, offset int, options listOptions) ([]T, error) {
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+
+	query := url.Values{}
+	query.Set("pg[offset]", strconv.Itoa(offset))
+	query.Set("pg[limit]", strconv.Itoa(listPageSize))
+	if options.SortBy != "" {
+		query.Set("pg[sortBy]", options.SortBy)
+	}
+	if options.SortDir != "" {
+		query.Set("pg[sortDir]", options.SortDir)
+	}
+
+	resp, err := c.MakeRequest(ctx, "GET", endpoint+separator+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == 404 && options.NotFound != "" {
+		_ = resp.Body.Close()
+		return nil, &NotFoundError{Message: options.NotFound}
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var result map[string]json.RawMessage
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	var page []T
+	if raw, ok := result[key]; ok {
+		if err := json.Unmarshal(raw, &page); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return page, nil
+}
+
+// CreateScenario creates a new scenario in Make.com
+func (c *Client) CreateScenario(ctx context.Context, req ScenarioRequest) (*ScenarioResponse, error) {
+	resp, err := c.MakeRequest(ctx, "POST", "v2/scenarios", req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var scenario ScenarioResponse
+	if err := json.NewDecoder(resp.Body).Decode(&scenario); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &scenario, nil
+}
+
+// GetScenario retrieves a scenario by ID from Make.com
+func (c *Client) GetScenario(ctx context.Context, id string) (*ScenarioResponse, error) {
+	endpoint := fmt.Sprintf("v2/scenarios/%s", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("scenario with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var scenario ScenarioResponse
+	if err := json.NewDecoder(resp.Body).Decode(&scenario); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &scenario, nil
+}
+
+// ListScenarios retrieves all scenarios of a team from Make.com
+func (c *Client) ListScenarios(ctx context.Context, teamID string) ([]ScenarioResponse, error) {
+	endpoint := "v2/scenarios?" + url.Values{"team_id": {teamID}}.Encode()
+	return listAll[ScenarioResponse](ctx, c, endpoint, "scenarios", listOptions{})
+}
+
+// UpdateScenario updates an existing scenario in Make.com, sending only the fields
+// of req that differ from prior, the request matching its current state.
+// Without changes nothing is written and the scenario is only read.
+func (c *Client) UpdateScenario(ctx context.Context, id string, prior, req ScenarioRequest) (*ScenarioResponse, error) {
+	endpoint := fmt.Sprintf("v2/scenarios/%s", id)
+	fields, err := patchFields(prior, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	if len(fields) == 0 {
+		tflog.Debug(ctx, "skipping Make API update without changes", map[string]interface{}{"endpoint": endpoint})
+		return c.GetScenario(ctx, id)
+	}
+
+	resp, err := c.MakeRequest(ctx, "PATCH", endpoint, fields)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("scenario with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var scenario ScenarioResponse
+	if err := json.NewDecoder(resp.Body).Decode(&scenario); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &scenario, nil
+}
+
+// GetScenarioBlueprint retrieves the blueprint of a scenario from Make.com as a JSON string
+func (c *Client) GetScenarioBlueprint(ctx context.Context, id string) (string, error) {
+	endpoint := fmt.Sprintf("v2/scenarios/%s/blueprint", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return "", notFoundError("blueprint of scenario with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return "", c.HandleErrorResponse(resp)
+	}
+
+	var result struct {
+		Blueprint json.RawMessage `json:"blueprint"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return string(result.Blueprint), nil
+}
+
+// StopScenario deactivates a scenario in Make.com, so that it is no longer
+// scheduled nor triggered. Executions in progress run to their end.
+func (c *Client) StopScenario(ctx context.Context, id string) (*ScenarioResponse, error) {
+	endpoint := fmt.Sprintf("v2/scenarios/%s/stop", id)
+	resp, err := c.MakeRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("scenario with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var scenario ScenarioResponse
+	if err := json.NewDecoder(resp.Body).Decode(&scenario); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &scenario, nil
+}
+
+// ListScenarioExecutions retrieves the executions of a scenario from Make.com,
+// only those of the given status, e.g. "running", unless it is empty
+func (c *Client) ListScenarioExecutions(ctx context.Context, id, status string) ([]ScenarioExecution, error) {
+	endpoint := fmt.Sprintf("v2/scenarios/%s/executions", id)
+	if status != "" {
+		endpoint += "?" + url.Values{"status": {status}}.Encode()
+	}
+	return listAll[ScenarioExecution](ctx, c, endpoint, "executions", listOptions{})
+}
+
+// DeleteScenario deletes a scenario from Make.com
+func (c *Client) DeleteScenario(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("v2/scenarios/%s", id)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// ConnectionResponse represents a Make.com connection from the API
+type ConnectionResponse struct {
+	ID         ID                     `json:"id"`
+	Name       string                 `json:"name"`
+	AppName    string                 `json:"app_name"`
+	TeamID     ID                     `json:"team_id,omitempty"`
+	Verified   bool                   `json:"verified"`
+	Settings   map[string]interface{} `json:"settings,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// ConnectionRequest represents the request payload for creating connections
+type ConnectionRequest struct {
+	Name       string                 `json:"name"`
+	AppName    string                 `json:"app_name"`
+	TeamID     string                 `json:"team_id,omitempty"`
+	Settings   map[string]interface{} `json:"settings,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// CreateConnection creates a new connection in Make.com
+func (c *Client) CreateConnection(ctx context.Context, req ConnectionRequest) (*ConnectionResponse, error) {
+	resp, err := c.MakeRequest(ctx, "POST", "v2/connections", req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var connection ConnectionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&connection); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &connection, nil
+}
+
+// GetConnection retrieves a connection by ID from Make.com
+func (c *Client) GetConnection(ctx context.Context, id string) (*ConnectionResponse, error) {
+	endpoint := fmt.Sprintf("v2/connections/%s", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("connection with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var connection ConnectionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&connection); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &connection, nil
+}
+
+// ListConnections retrieves all connections of a team from Make.com
+func (c *Client) ListConnections(ctx context.Context, teamID string) ([]ConnectionResponse, error) {
+	endpoint := "v2/connections?" + url.Values{"team_id": {teamID}}.Encode()
+	return listAll[ConnectionResponse](ctx, c, endpoint, "connections", listOptions{})
+}
+
+// UpdateConnection updates an existing connection in Make.com, sending only
+// the fields of req that differ from the prior request
+func (c *Client) UpdateConnection(ctx context.Context, id string, prior, req ConnectionRequest) (*ConnectionResponse, error) {
+	endpoint := fmt.Sprintf("v2/connections/%s", id)
+	fields, err := patchFields(prior, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	if len(fields) == 0 {
+		tflog.Debug(ctx, "skipping Make API update without changes", map[string]interface{}{"endpoint": endpoint})
+		return c.GetConnection(ctx, id)
+	}
+
+	resp, err := c.MakeRequest(ctx, "PATCH", endpoint, fields)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("connection with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var connection ConnectionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&connection); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &connection, nil
+}
+
+// DeleteConnection deletes a connection from Make.com
+func (c *Client) DeleteConnection(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("v2/connections/%s", id)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// WebhookResponse represents a Make.com webhook from the API
+type WebhookResponse struct {
+	ID       ID               `json:"id"`
+	Name     string           `json:"name"`
+	URL      string           `json:"url"`
+	Email    string           `json:"email,omitempty"`
+	Type     string           `json:"type,omitempty"`
+	Status   string           `json:"status,omitempty"`
+	TeamID   ID               `json:"team_id,omitempty"`
+	Active   bool             `json:"active"`
+	Settings *WebhookSettings `json:"settings,omitempty"`
+	Queue    *WebhookQueue    `json:"queue,omitempty"`
+
+	DataStructureID ID            `json:"data_structure_id,omitempty"`
+	Learning        bool          `json:"learning"`
+	IPRestrictions  []string      `json:"ip_restrictions,omitempty"`
+	Response        *WebhookReply `json:"response,omitempty"`
+}
+
+// WebhookRequest represents the request payload for creating/updating webhooks
+type WebhookRequest struct {
+	Name     string           `json:"name"`
+	URL      string           `json:"url"`
+	Type     string           `json:"type,omitempty"`
+	TeamID   string           `json:"team_id,omitempty"`
+	Active   bool             `json:"active"`
+	Settings *WebhookSettings `json:"settings,omitempty"`
+	Queue    *WebhookQueue    `json:"queue,omitempty"`
+
+	// DataStructureID is null rather than omitted when unset, so that removing
+	// it unbinds the data structure
+	DataStructureID *string `json:"data_structure_id"`
+
+	// IPRestrictions is null rather than omitted when unset, so that removing
+	// it lifts the restriction
+	IPRestrictions []string `json:"ip_restrictions"`
+
+	// Response is null rather than omitted when unset, so that removing it
+	// restores the default reply
+	Response *WebhookReply `json:"response"`
+}
+
+// WebhookSettings holds the advanced settings of a webhook, controlling which
+// parts of an incoming request reach the scenario
+type WebhookSettings struct {
+	Method    bool `json:"method"`
+	Headers   bool `json:"headers"`
+	Stringify bool `json:"stringify"`
+}
+
+// WebhookReply describes the custom response a webhook replies with once it
+// accepted a request
+type WebhookReply struct {
+	StatusCode int64             `json:"status_code"`
+	Body       string            `json:"body,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+}
+
+// WebhookQueue holds the queue options of a webhook, which keeps incoming
+// requests until the scenario processes them
+type WebhookQueue struct {
+	MaxSize      int64 `json:"max_size,omitempty"`
+	StopOnError  bool  `json:"stop_on_error"`
+	StoreResults bool  `json:"store_results"`
+}
+
+// CreateWebhook creates a new webhook in Make.com
+func (c *Client) CreateWebhook(ctx context.Context, req WebhookRequest) (*WebhookResponse, error) {
+	resp, err := c.MakeRequest(ctx, "POST", "v2/webhooks", req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var webhook WebhookResponse
+	if err := json.NewDecoder(resp.Body).Decode(&webhook); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+// GetWebhook retrieves a webhook by ID from Make.com
+func (c *Client) GetWebhook(ctx context.Context, id string) (*WebhookResponse, error) {
+	endpoint := fmt.Sprintf("v2/webhooks/%s", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("webhook with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var webhook WebhookResponse
+	if err := json.NewDecoder(resp.Body).Decode(&webhook); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+// ListWebhooks retrieves all webhooks of a team from Make.com
+func (c *Client) ListWebhooks(ctx context.Context, teamID string) ([]WebhookResponse, error) {
+	endpoint := "v2/webhooks?" + url.Values{"team_id": {teamID}}.Encode()
+	return listAll[WebhookResponse](ctx, c, endpoint, "webhooks", listOptions{})
+}
+
+// UpdateWebhook updates an existing webhook in Make.com, sending only the
+// fields of req that differ from the prior request
+func (c *Client) UpdateWebhook(ctx context.Context, id string, prior, req WebhookRequest) (*WebhookResponse, error) {
+	endpoint := fmt.Sprintf("v2/webhooks/%s", id)
+	fields, err := patchFields(prior, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	if len(fields) == 0 {
+		tflog.Debug(ctx, "skipping Make API update without changes", map[string]interface{}{"endpoint": endpoint})
+		return c.GetWebhook(ctx, id)
+	}
+
+	resp, err := c.MakeRequest(ctx, "PATCH", endpoint, fields)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("webhook with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var webhook WebhookResponse
+	if err := json.NewDecoder(resp.Body).Decode(&webhook); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+// DeleteWebhook deletes a webhook from Make.com. Make refuses to delete a
+// webhook still used by a scenario unless confirmed is set.
+func (c *Client) DeleteWebhook(ctx context.Context, id string, confirmed bool) error {
+	endpoint := fmt.Sprintf("v2/webhooks/%s", id)
+	if confirmed {
+		endpoint += "?" + url.Values{"confirmed": {"true"}}.Encode()
+	}
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// RotateWebhookURL regenerates the URL (or mailhook email address) of a webhook
+// in Make.com, invalidating the previous one
+func (c *Client) RotateWebhookURL(ctx context.Context, id string) (*WebhookResponse, error) {
+	endpoint := fmt.Sprintf("v2/webhooks/%s/rotate-url", id)
+	resp, err := c.MakeRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("webhook with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var webhook WebhookResponse
+	if err := json.NewDecoder(resp.Body).Decode(&webhook); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+// SetWebhookEnabled enables or disables a webhook in Make.com without
+// otherwise changing it
+func (c *Client) SetWebhookEnabled(ctx context.Context, id string, enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+
+	endpoint := fmt.Sprintf("v2/webhooks/%s/%s", id, action)
+	resp, err := c.MakeRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return notFoundError("webhook with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// SetWebhookLearning starts or stops the "determine data structure" learning
+// mode of a webhook in Make.com
+func (c *Client) SetWebhookLearning(ctx context.Context, id string, enabled bool) error {
+	action := "learn-stop"
+	if enabled {
+		action = "learn-start"
+	}
+
+	endpoint := fmt.Sprintf("v2/webhooks/%s/%s", id, action)
+	resp, err := c.MakeRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return notFoundError("webhook with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// WebhookLog represents a delivery to a Make.com webhook from the API
+type WebhookLog struct {
+	ID          ID     `json:"id"`
+	Status      string `json:"status"`
+	SizeBytes   int64  `json:"size"`
+	ReceivedAt  string `json:"received_at"`
+	ProcessedAt string `json:"processed_at,omitempty"`
+}
+
+// ListWebhookLogs retrieves the most recent deliveries to a webhook from
+// Make.com, newest first. A limit of zero uses the default page size of the API.
+func (c *Client) ListWebhookLogs(ctx context.Context, webhookID string, limit int) ([]WebhookLog, error) {
+	endpoint := fmt.Sprintf("v2/webhooks/%s/logs", webhookID)
+	if limit > 0 {
+		endpoint += "?" + url.Values{"pg[limit]": {strconv.Itoa(limit)}}.Encode()
+	}
+
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("webhook with ID %s not found", webhookID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var result struct {
+		Logs []WebhookLog `json:"logs"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.Logs, nil
+}
+
+// TeamResponse represents a Make.com team from the API
+type TeamResponse struct {
+	ID             ID     `json:"id"`
+	Name           string `json:"name"`
+	OrganizationID ID     `json:"organization_id,omitempty"`
+	// Limits are nil when the team is not capped
+	OperationsLimit     *int64 `json:"operations_limit,omitempty"`
+	DataTransferLimitMB *int64 `json:"data_transfer_limit_mb,omitempty"`
+}
+
+// TeamRequest represents the request payload for creating/updating teams
+type TeamRequest struct {
+	Name                string `json:"name"`
+	OrganizationID      string `json:"organization_id,omitempty"`
+	OperationsLimit     *int64 `json:"operations_limit,omitempty"`
+	DataTransferLimitMB *int64 `json:"data_transfer_limit_mb,omitempty"`
+}
+
+// CreateTeam creates a new team in Make.com
+func (c *Client) CreateTeam(ctx context.Context, req TeamRequest) (*TeamResponse, error) {
+	resp, err := c.MakeRequest(ctx, "POST", "v2/teams", req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var team TeamResponse
+	if err := json.NewDecoder(resp.Body).Decode(&team); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &team, nil
+}
+
+// GetTeam retrieves a team by ID from Make.com
+func (c *Client) GetTeam(ctx context.Context, id string) (*TeamResponse, error) {
+	endpoint := fmt.Sprintf("v2/teams/%s", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("team with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var team TeamResponse
+	if err := json.NewDecoder(resp.Body).Decode(&team); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &team, nil
+}
+
+// UpdateTeam updates an existing team in Make.com, sending only the fields
+// of req that differ from prior, the request matching its current state.
+// Without changes nothing is written and the team is only read.
+func (c *Client) UpdateTeam(ctx context.Context, id string, prior, req TeamRequest) (*TeamResponse, error) {
+	endpoint := fmt.Sprintf("v2/teams/%s", id)
+	fields, err := patchFields(prior, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	if len(fields) == 0 {
+		tflog.Debug(ctx, "skipping Make API update without changes", map[string]interface{}{"endpoint": endpoint})
+		return c.GetTeam(ctx, id)
+	}
+
+	resp, err := c.MakeRequest(ctx, "PATCH", endpoint, fields)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("team with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var team TeamResponse
+	if err := json.NewDecoder(resp.Body).Decode(&team); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &team, nil
+}
+
+// DeleteTeam deletes a team from Make.com
+func (c *Client) DeleteTeam(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("v2/teams/%s", id)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// ListTeams retrieves all teams of an organization from Make.com
+func (c *Client) ListTeams(ctx context.Context, organizationID string) ([]TeamResponse, error) {
+	endpoint := "v2/teams?" + url.Values{"organization_id": {organizationID}}.Encode()
+	return listAll[TeamResponse](ctx, c, endpoint, "teams", listOptions{})
+}
+
+// TeamMemberResponse represents a user's membership of a Make.com team from the API
+type TeamMemberResponse struct {
+	UserID ID     `json:"user_id"`
+	TeamID ID     `json:"team_id"`
+	Role   string `json:"role"`
+}
+
+// TeamMemberRequest represents the request payload for adding/updating team members
+type TeamMemberRequest struct {
+	UserID string `json:"user_id,omitempty"`
+	Role   string `json:"role"`
+}
+
+// AddTeamMember adds a user to a team in Make.com
+func (c *Client) AddTeamMember(ctx context.Context, teamID string, req TeamMemberRequest) (*TeamMemberResponse, error) {
+	endpoint := fmt.Sprintf("v2/teams/%s/users", teamID)
+	resp, err := c.MakeRequest(ctx, "POST", endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var member TeamMemberResponse
+	if err := json.NewDecoder(resp.Body).Decode(&member); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &member, nil
+}
+
+// GetTeamMember retrieves the membership of a user in a team from Make.com
+func (c *Client) GetTeamMember(ctx context.Context, teamID, userID string) (*TeamMemberResponse, error) {
+	endpoint := fmt.Sprintf("v2/teams/%s/users/%s", teamID, userID)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, fmt.Errorf("user with ID %s is not a member of team %s", userID, teamID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var member TeamMemberResponse
+	if err := json.NewDecoder(resp.Body).Decode(&member); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &member, nil
+}
+
+// UpdateTeamMember changes the role of a user in a team in Make.com
+func (c *Client) UpdateTeamMember(ctx context.Context, teamID, userID string, req TeamMemberRequest) (*TeamMemberResponse, error) {
+	endpoint := fmt.Sprintf("v2/teams/%s/users/%s", teamID, userID)
+	resp, err := c.MakeRequest(ctx, "PUT", endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, fmt.Errorf("user with ID %s is not a member of team %s", userID, teamID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var member TeamMemberResponse
+	if err := json.NewDecoder(resp.Body).Decode(&member); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &member, nil
+}
+
+// RemoveTeamMember removes a user from a team in Make.com
+func (c *Client) RemoveTeamMember(ctx context.Context, teamID, userID string) error {
+	endpoint := fmt.Sprintf("v2/teams/%s/users/%s", teamID, userID)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already removed or never a member
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// DailyUsage represents the consumption of a Make.com team or organization
+// on a single day
+type DailyUsage struct {
+	Date           string `json:"date"`
+	Operations     int64  `json:"operations"`
+	DataTransferMB int64  `json:"data_transfer_mb"`
+}
+
+// usageEndpoint adds the bounds of the reported period to a usage endpoint.
+// Empty bounds default to the current billing period.
+func usageEndpoint(endpoint, from, to string) string {
+	query := url.Values{}
+	if from != "" {
+		query.Set("from", from)
+	}
+	if to != "" {
+		query.Set("to", to)
+	}
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	return endpoint
+}
+
+// GetTeamUsage retrieves the daily consumption of a team from Make.com
+func (c *Client) GetTeamUsage(ctx context.Context, teamID, from, to string) ([]DailyUsage, error) {
+	endpoint := usageEndpoint(fmt.Sprintf("v2/teams/%s/usage", teamID), from, to)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("team with ID %s not found", teamID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var result struct {
+		Usage []DailyUsage `json:"usage"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.Usage, nil
+}
+
+// OrganizationResponse represents a Make.com organization from the API
+type OrganizationResponse struct {
+	ID   ID     `json:"id"`
+	Name string `json:"name"`
+}
+
+// OrganizationRequest represents the request payload for creating/updating organizations
+type OrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateOrganization creates a new organization in Make.com
+func (c *Client) CreateOrganization(ctx context.Context, req OrganizationRequest) (*OrganizationResponse, error) {
+	resp, err := c.MakeRequest(ctx, "POST", "v2/organizations", req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var org OrganizationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&org); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &org, nil
+}
+
+// GetOrganization retrieves an organization by ID from Make.com
+func (c *Client) GetOrganization(ctx context.Context, id string) (*OrganizationResponse, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("organization with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var org OrganizationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&org); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &org, nil
+}
+
+// ListOrganizations retrieves all organizations the API token has access to
+func (c *Client) ListOrganizations(ctx context.Context) ([]OrganizationResponse, error) {
+	return listAll[OrganizationResponse](ctx, c, "v2/organizations", "organizations", listOptions{})
+}
+
+// UpdateOrganization updates an existing organization in Make.com, sending only the fields
+// of req that differ from prior, the request matching its current state.
+// Without changes nothing is written and the organization is only read.
+func (c *Client) UpdateOrganization(ctx context.Context, id string, prior, req OrganizationRequest) (*OrganizationResponse, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s", id)
+	fields, err := patchFields(prior, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	if len(fields) == 0 {
+		tflog.Debug(ctx, "skipping Make API update without changes", map[string]interface{}{"endpoint": endpoint})
+		return c.GetOrganization(ctx, id)
+	}
+
+	resp, err := c.MakeRequest(ctx, "PATCH", endpoint, fields)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("organization with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var org OrganizationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&org); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &org, nil
+}
+
+// DeleteOrganization deletes an organization from Make.com
+func (c *Client) DeleteOrganization(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("v2/organizations/%s", id)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// OrganizationSettings represents the settings of a Make.com organization.
+// Empty fields are left unchanged on update.
+type OrganizationSettings struct {
+	Timezone    string          `json:"timezone,omitempty"`
+	CountryCode string          `json:"country_code,omitempty"`
+	Features    map[string]bool `json:"features,omitempty"`
+}
+
+// GetOrganizationSettings retrieves the settings of an organization from Make.com
+func (c *Client) GetOrganizationSettings(ctx context.Context, organizationID string) (*OrganizationSettings, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/settings", organizationID)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("organization with ID %s not found", organizationID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var settings OrganizationSettings
+	if err := json.NewDecoder(resp.Body).Decode(&settings); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// UpdateOrganizationSettings updates the settings of an organization in Make.com
+func (c *Client) UpdateOrganizationSettings(ctx context.Context, organizationID string, settings OrganizationSettings) (*OrganizationSettings, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/settings", organizationID)
+	resp, err := c.MakeRequest(ctx, "PUT", endpoint, settings)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("organization with ID %s not found", organizationID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var updated OrganizationSettings
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// OrganizationSubscription represents the subscription of a Make.com
+// organization and its consumption in the current billing period
+type OrganizationSubscription struct {
+	Plan                string          `json:"plan"`
+	OperationsLimit     int64           `json:"operations_limit"`
+	OperationsUsed      int64           `json:"operations_used"`
+	DataTransferLimitMB int64           `json:"data_transfer_limit_mb"`
+	DataTransferUsedMB  int64           `json:"data_transfer_used_mb"`
+	PeriodStart         string          `json:"period_start"`
+	PeriodEnd           string          `json:"period_end"`
+	Features            map[string]bool `json:"features"`
+}
+
+// GetOrganizationSubscription retrieves the subscription of an organization from Make.com
+func (c *Client) GetOrganizationSubscription(ctx context.Context, organizationID string) (*OrganizationSubscription, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/subscription", organizationID)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("organization with ID %s not found", organizationID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var subscription OrganizationSubscription
+	if err := json.NewDecoder(resp.Body).Decode(&subscription); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &subscription, nil
+}
+
+// ScenarioUsage represents the consumption of a single scenario over a period
+type ScenarioUsage struct {
+	ScenarioID     ID     `json:"scenario_id"`
+	ScenarioName   string `json:"scenario_name"`
+	TeamID         ID     `json:"team_id"`
+	Executions     int64  `json:"executions"`
+	Errors         int64  `json:"errors"`
+	Operations     int64  `json:"operations"`
+	DataTransferMB int64  `json:"data_transfer_mb"`
+}
+
+// OrganizationAnalytics represents the consumption of a Make.com organization
+// over a period, per day and per scenario
+type OrganizationAnalytics struct {
+	Days      []DailyUsage    `json:"days"`
+	Scenarios []ScenarioUsage `json:"scenarios"`
+}
+
+// GetOrganizationAnalytics retrieves the consumption of an organization from Make.com
+func (c *Client) GetOrganizationAnalytics(ctx context.Context, organizationID, from, to string) (*OrganizationAnalytics, error) {
+	endpoint := usageEndpoint(fmt.Sprintf("v2/organizations/%s/analytics", organizationID), from, to)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("organization with ID %s not found", organizationID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var analytics OrganizationAnalytics
+	if err := json.NewDecoder(resp.Body).Decode(&analytics); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &analytics, nil
+}
+
+// OrganizationMemberResponse represents a user's membership of a Make.com organization from the API
+type OrganizationMemberResponse struct {
+	UserID         ID     `json:"user_id"`
+	OrganizationID ID     `json:"organization_id"`
+	Role           string `json:"role"`
+}
+
+// OrganizationMemberRequest represents the request payload for adding/updating organization members
+type OrganizationMemberRequest struct {
+	UserID string `json:"user_id,omitempty"`
+	Role   string `json:"role"`
+}
+
+// AddOrganizationMember adds a user to an organization in Make.com
+func (c *Client) AddOrganizationMember(ctx context.Context, organizationID string, req OrganizationMemberRequest) (*OrganizationMemberResponse, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/users", organizationID)
+	resp, err := c.MakeRequest(ctx, "POST", endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var member OrganizationMemberResponse
+	if err := json.NewDecoder(resp.Body).Decode(&member); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &member, nil
+}
+
+// GetOrganizationMember retrieves the membership of a user in an organization from Make.com
+func (c *Client) GetOrganizationMember(ctx context.Context, organizationID, userID string) (*OrganizationMemberResponse, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/users/%s", organizationID, userID)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, fmt.Errorf("user with ID %s is not a member of organization %s", userID, organizationID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var member OrganizationMemberResponse
+	if err := json.NewDecoder(resp.Body).Decode(&member); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &member, nil
+}
+
+// UpdateOrganizationMember changes the role of a user in an organization in Make.com
+func (c *Client) UpdateOrganizationMember(ctx context.Context, organizationID, userID string, req OrganizationMemberRequest) (*OrganizationMemberResponse, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/users/%s", organizationID, userID)
+	resp, err := c.MakeRequest(ctx, "PUT", endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, fmt.Errorf("user with ID %s is not a member of organization %s", userID, organizationID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var member OrganizationMemberResponse
+	if err := json.NewDecoder(resp.Body).Decode(&member); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &member, nil
+}
+
+// RemoveOrganizationMember removes a user from an organization in Make.com
+func (c *Client) RemoveOrganizationMember(ctx context.Context, organizationID, userID string) error {
+	endpoint := fmt.Sprintf("v2/organizations/%s/users/%s", organizationID, userID)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already removed or never a member
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// OrganizationInviteResponse represents a Make.com organization invitation from the API
+type OrganizationInviteResponse struct {
+	ID             ID     `json:"id"`
+	OrganizationID ID     `json:"organization_id"`
+	Email          string `json:"email"`
+	Role           string `json:"role"`
+	TeamIDs        []ID   `json:"team_ids,omitempty"`
+	Status         string `json:"status"`
+}
+
+// OrganizationInviteRequest represents the request payload for inviting users to organizations
+type OrganizationInviteRequest struct {
+	Email   string   `json:"email"`
+	Role    string   `json:"role"`
+	TeamIDs []string `json:"team_ids,omitempty"`
+}
+
+// CreateOrganizationInvite invites a user to an organization in Make.com
+func (c *Client) CreateOrganizationInvite(ctx context.Context, organizationID string, req OrganizationInviteRequest) (*OrganizationInviteResponse, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/invitations", organizationID)
+	resp, err := c.MakeRequest(ctx, "POST", endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var invite OrganizationInviteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&invite); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &invite, nil
+}
+
+// GetOrganizationInvite retrieves an organization invitation by ID from Make.com
+func (c *Client) GetOrganizationInvite(ctx context.Context, organizationID, id string) (*OrganizationInviteResponse, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/invitations/%s", organizationID, id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("invitation with ID %s not found in organization %s", id, organizationID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var invite OrganizationInviteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&invite); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &invite, nil
+}
+
+// RevokeOrganizationInvite revokes a pending organization invitation in Make.com
+func (c *Client) RevokeOrganizationInvite(ctx context.Context, organizationID, id string) error {
+	endpoint := fmt.Sprintf("v2/organizations/%s/invitations/%s", organizationID, id)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already revoked or expired
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// DataStoreResponse represents a Make.com data store from the API
+type DataStoreResponse struct {
+	ID              ID     `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description,omitempty"`
+	TeamID          ID     `json:"team_id,omitempty"`
+	DataStructureID ID     `json:"data_structure_id,omitempty"`
+	MaxSizeMB       int64  `json:"max_size_mb"`
+	Strict          bool   `json:"strict"`
+	Records         int64  `json:"records"`
+	Size            int64  `json:"size"`
+}
+
+// DataStoreRequest represents the request payload for creating/updating data stores
+type DataStoreRequest struct {
+	Name            string `json:"name"`
+	Description     string `json:"description,omitempty"`
+	TeamID          string `json:"team_id,omitempty"`
+	DataStructureID string `json:"data_structure_id"`
+	MaxSizeMB       int64  `json:"max_size_mb"`
+	Strict          bool   `json:"strict"`
+}
+
+// CreateDataStore creates a new data store in Make.com
+func (c *Client) CreateDataStore(ctx context.Context, req DataStoreRequest) (*DataStoreResponse, error) {
+	resp, err := c.MakeRequest(ctx, "POST", "v2/data-stores", req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var ds DataStoreResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ds); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &ds, nil
+}
+
+// GetDataStore retrieves a data store by ID from Make.com
+func (c *Client) GetDataStore(ctx context.Context, id string) (*DataStoreResponse, error) {
+	endpoint := fmt.Sprintf("v2/data-stores/%s", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("data store with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var ds DataStoreResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ds); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &ds, nil
+}
+
+// UpdateDataStore updates an existing data store in Make.com, sending only the fields
+// of req that differ from prior, the request matching its current state.
+// Without changes nothing is written and the data store is only read.
+func (c *Client) UpdateDataStore(ctx context.Context, id string, prior, req DataStoreRequest) (*DataStoreResponse, error) {
+	endpoint := fmt.Sprintf("v2/data-stores/%s", id)
+	fields, err := patchFields(prior, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	if len(fields) == 0 {
+		tflog.Debug(ctx, "skipping Make API update without changes", map[string]interface{}{"endpoint": endpoint})
+		return c.GetDataStore(ctx, id)
+	}
+
+	resp, err := c.MakeRequest(ctx, "PATCH", endpoint, fields)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("data store with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var ds DataStoreResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ds); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &ds, nil
+}
+
+// ListDataStores retrieves all data stores of a team from Make.com
+func (c *Client) ListDataStores(ctx context.Context, teamID string) ([]DataStoreResponse, error) {
+	endpoint := "v2/data-stores?" + url.Values{"team_id": {teamID}}.Encode()
+	return listAll[DataStoreResponse](ctx, c, endpoint, "data_stores", listOptions{})
+}
+
+// DeleteDataStore deletes a data store from Make.com
+func (c *Client) DeleteDataStore(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("v2/data-stores/%s", id)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// dataStoreRecordsBatchSize is the maximum number of records sent in a single
+// batch request
+const dataStoreRecordsBatchSize = 100
+
+// DataStoreRecord represents a record of a Make.com data store
+type DataStoreRecord struct {
+	Key  string          `json:"key"`
+	Data json.RawMessage `json:"data"`
+}
+
+// DataStoreRecordsRequest represents the request payload for writing data store records in bulk
+type DataStoreRecordsRequest struct {
+	Records   []DataStoreRecord `json:"records"`
+	Overwrite bool              `json:"overwrite"`
+}
+
+// ListDataStoreRecords retrieves all records of a data store from Make.com
+func (c *Client) ListDataStoreRecords(ctx context.Context, dataStoreID string) ([]DataStoreRecord, error) {
+	endpoint := fmt.Sprintf("v2/data-stores/%s/data", dataStoreID)
+	return listAll[DataStoreRecord](ctx, c, endpoint, "records", listOptions{
+		NotFound: fmt.Sprintf("data store %s not found", dataStoreID),
+	})
+}
+
+// WriteDataStoreRecords inserts records into a data store in Make.com, in
+// batches of dataStoreRecordsBatchSize. Existing records are only replaced
+// when overwrite is set.
+func (c *Client) WriteDataStoreRecords(ctx context.Context, dataStoreID string, records []DataStoreRecord, overwrite bool) error {
+	endpoint := fmt.Sprintf("v2/data-stores/%s/data/batch", dataStoreID)
+	for start := 0; start < len(records); start += dataStoreRecordsBatchSize {
+		end := min(start+dataStoreRecordsBatchSize, len(records))
+
+		resp, err := c.MakeRequest(ctx, "POST", endpoint, DataStoreRecordsRequest{
+			Records:   records[start:end],
+			Overwrite: overwrite,
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode >= 400 {
+			return c.HandleErrorResponse(resp)
+		}
+		_ = resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteDataStoreRecords deletes records by key from a data store in Make.com,
+// in batches of dataStoreRecordsBatchSize
+func (c *Client) DeleteDataStoreRecords(ctx context.Context, dataStoreID string, keys []string) error {
+	endpoint := fmt.Sprintf("v2/data-stores/%s/data", dataStoreID)
+	for start := 0; start < len(keys); start += dataStoreRecordsBatchSize {
+		end := min(start+dataStoreRecordsBatchSize, len(keys))
+
+		resp, err := c.MakeRequest(ctx, "DELETE", endpoint, map[string][]string{
+			"keys": keys[start:end],
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == 404 {
+			// Data store already deleted, so are its records
+			_ = resp.Body.Close()
+			return nil
+		}
+
+		if resp.StatusCode >= 400 {
+			return c.HandleErrorResponse(resp)
+		}
+		_ = resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DataStructureResponse represents a Make.com data structure from the API
+type DataStructureResponse struct {
+	ID     ID              `json:"id"`
+	Name   string          `json:"name"`
+	TeamID ID              `json:"team_id,omitempty"`
+	Strict bool            `json:"strict"`
+	Spec   json.RawMessage `json:"spec,omitempty"`
+}
+
+// ListDataStructures retrieves all data structures of a team from Make.com
+func (c *Client) ListDataStructures(ctx context.Context, teamID string) ([]DataStructureResponse, error) {
+	endpoint := fmt.Sprintf("v2/teams/%s/data-structures", teamID)
+	return listAll[DataStructureResponse](ctx, c, endpoint, "data_structures", listOptions{})
+}
+
+// Types of Make custom variables
+const (
+	CustomVariableTypeString  = "string"
+	CustomVariableTypeNumber  = "number"
+	CustomVariableTypeBoolean = "boolean"
+	CustomVariableTypeDate    = "date"
+)
+
+// CustomVariableResponse represents a Make.com custom variable from the API.
+// Value is omitted for secret variables.
+type CustomVariableResponse struct {
+	Name     string      `json:"name"`
+	TeamID   ID          `json:"team_id,omitempty"`
+	Value    interface{} `json:"value,omitempty"`
+	Type     string      `json:"type"`
+	IsSecret bool        `json:"is_secret"`
+}
+
+// CustomVariableRequest represents the request payload for creating/updating custom variables
+type CustomVariableRequest struct {
+	Name     string      `json:"name,omitempty"`
+	Value    interface{} `json:"value"`
+	Type     string      `json:"type"`
+	IsSecret bool        `json:"is_secret"`
+}
+
+// CreateTeamVariable creates a custom variable in a team in Make.com
+func (c *Client) CreateTeamVariable(ctx context.Context, teamID string, req CustomVariableRequest) (*CustomVariableResponse, error) {
+	endpoint := fmt.Sprintf("v2/teams/%s/variables", teamID)
+	resp, err := c.MakeRequest(ctx, "POST", endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var variable CustomVariableResponse
+	if err := json.NewDecoder(resp.Body).Decode(&variable); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &variable, nil
+}
+
+// GetTeamVariable retrieves a custom variable of a team by name from Make.com
+func (c *Client) GetTeamVariable(ctx context.Context, teamID, name string) (*CustomVariableResponse, error) {
+	endpoint := fmt.Sprintf("v2/teams/%s/variables/%s", teamID, name)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("variable %s not found in team %s", name, teamID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var variable CustomVariableResponse
+	if err := json.NewDecoder(resp.Body).Decode(&variable); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &variable, nil
+}
+
+// UpdateTeamVariable updates a custom variable of a team in Make.com
+func (c *Client) UpdateTeamVariable(ctx context.Context, teamID, name string, req CustomVariableRequest) (*CustomVariableResponse, error) {
+	endpoint := fmt.Sprintf("v2/teams/%s/variables/%s", teamID, name)
+	resp, err := c.MakeRequest(ctx, "PUT", endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("variable %s not found in team %s", name, teamID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var variable CustomVariableResponse
+	if err := json.NewDecoder(resp.Body).Decode(&variable); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &variable, nil
+}
+
+// DeleteTeamVariable deletes a custom variable of a team from Make.com
+func (c *Client) DeleteTeamVariable(ctx context.Context, teamID, name string) error {
+	endpoint := fmt.Sprintf("v2/teams/%s/variables/%s", teamID, name)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// ListTeamVariables retrieves all custom variables of a team from Make.com
+func (c *Client) ListTeamVariables(ctx context.Context, teamID string) ([]CustomVariableResponse, error) {
+	return c.listCustomVariables(ctx, fmt.Sprintf("v2/teams/%s/variables", teamID))
+}
+
+// ListOrganizationVariables retrieves all custom variables of an organization from Make.com
+func (c *Client) ListOrganizationVariables(ctx context.Context, organizationID string) ([]CustomVariableResponse, error) {
+	return c.listCustomVariables(ctx, fmt.Sprintf("v2/organizations/%s/variables", organizationID))
+}
+
+func (c *Client) listCustomVariables(ctx context.Context, endpoint string) ([]CustomVariableResponse, error) {
+	return listAll[CustomVariableResponse](ctx, c, endpoint, "variables", listOptions{})
+}
+
+// CustomAppResponse represents a Make.com custom app from the API
+type CustomAppResponse struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Description string `json:"description,omitempty"`
+	Theme       string `json:"theme,omitempty"`
+	Version     int64  `json:"version"`
+	Public      bool   `json:"public"`
+}
+
+// CustomAppRequest represents the request payload for creating/updating custom apps
+type CustomAppRequest struct {
+	Name        string `json:"name,omitempty"`
+	Label       string `json:"label"`
+	Description string `json:"description,omitempty"`
+	Theme       string `json:"theme,omitempty"`
+	Version     int64  `json:"version,omitempty"`
+}
+
+// CreateCustomApp creates a new custom app in Make.com
+func (c *Client) CreateCustomApp(ctx context.Context, req CustomAppRequest) (*CustomAppResponse, error) {
+	resp, err := c.MakeRequest(ctx, "POST", "v2/sdk/apps", req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var app CustomAppResponse
+	if err := json.NewDecoder(resp.Body).Decode(&app); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &app, nil
+}
+
+// GetCustomApp retrieves a version of a custom app from Make.com
+func (c *Client) GetCustomApp(ctx context.Context, name string, version int64) (*CustomAppResponse, error) {
+	endpoint := fmt.Sprintf("v2/sdk/apps/%s/%d", name, version)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("custom app %s version %d not found", name, version)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var app CustomAppResponse
+	if err := json.NewDecoder(resp.Body).Decode(&app); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &app, nil
+}
+
+// UpdateCustomApp updates a version of a custom app in Make.com
+func (c *Client) UpdateCustomApp(ctx context.Context, name string, version int64, req CustomAppRequest) (*CustomAppResponse, error) {
+	endpoint := fmt.Sprintf("v2/sdk/apps/%s/%d", name, version)
+	resp, err := c.MakeRequest(ctx, "PUT", endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("custom app %s version %d not found", name, version)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var app CustomAppResponse
+	if err := json.NewDecoder(resp.Body).Decode(&app); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &app, nil
+}
+
+// DeleteCustomApp deletes a version of a custom app from Make.com
+func (c *Client) DeleteCustomApp(ctx context.Context, name string, version int64) error {
+	endpoint := fmt.Sprintf("v2/sdk/apps/%s/%d", name, version)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// Sections of a custom app that hold JSON documents
+const (
+	CustomAppSectionBase   = "base"
+	CustomAppSectionCommon = "common"
+	CustomAppSectionGroups = "groups"
+)
+
+// GetCustomAppSection retrieves a JSON section of a custom app version from Make.com
+func (c *Client) GetCustomAppSection(ctx context.Context, name string, version int64, section string) (json.RawMessage, error) {
+	endpoint := fmt.Sprintf("v2/sdk/apps/%s/%d/%s", name, version, section)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("custom app %s version %d not found", name, version)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var content json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&content); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return content, nil
+}
+
+// SetCustomAppSection replaces a JSON section of a custom app version in Make.com
+func (c *Client) SetCustomAppSection(ctx context.Context, name string, version int64, section string, content json.RawMessage) error {
+	endpoint := fmt.Sprintf("v2/sdk/apps/%s/%d/%s", name, version, section)
+	resp, err := c.MakeRequest(ctx, "PUT", endpoint, content)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return notFoundError("custom app %s version %d not found", name, version)
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// UploadCustomAppIcon uploads the icon of a custom app version to Make.com
+func (c *Client) UploadCustomAppIcon(ctx context.Context, name string, version int64, icon []byte) error {
+	endpoint := fmt.Sprintf("v2/sdk/apps/%s/%d/icon", name, version)
+	resp, err := c.MakeMultipartRequest(ctx, "PUT", endpoint, "icon", "icon.png", icon)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return notFoundError("custom app %s version %d not found", name, version)
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// SetCustomAppPublished publishes or unpublishes a custom app version in Make.com
+func (c *Client) SetCustomAppPublished(ctx context.Context, name string, version int64, published bool) error {
+	action := "unpublish"
+	if published {
+		action = "publish"
+	}
+
+	endpoint := fmt.Sprintf("v2/sdk/apps/%s/%d/%s", name, version, action)
+	resp, err := c.MakeRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return notFoundError("custom app %s version %d not found", name, version)
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// CustomAppInviteResponse represents an invitation for an organization to install a custom app
+type CustomAppInviteResponse struct {
+	OrganizationID ID     `json:"organization_id"`
+	CreatedAt      string `json:"created_at,omitempty"`
+}
+
+// CreateCustomAppInvite invites an organization to install a custom app version in Make.com
+func (c *Client) CreateCustomAppInvite(ctx context.Context, name string, version int64, organizationID string) (*CustomAppInviteResponse, error) {
+	endpoint := fmt.Sprintf("v2/sdk/apps/%s/%d/invites", name, version)
+	resp, err := c.MakeRequest(ctx, "POST", endpoint, map[string]string{
+		"organization_id": organizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var invite CustomAppInviteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&invite); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &invite, nil
+}
+
+// GetCustomAppInvite retrieves the invitation of an organization to install a custom app version from Make.com
+func (c *Client) GetCustomAppInvite(ctx context.Context, name string, version int64, organizationID string) (*CustomAppInviteResponse, error) {
+	endpoint := fmt.Sprintf("v2/sdk/apps/%s/%d/invites/%s", name, version, organizationID)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("invite of organization %s to custom app %s version %d not found", organizationID, name, version)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var invite CustomAppInviteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&invite); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &invite, nil
+}
+
+// DeleteCustomAppInvite revokes the invitation of an organization to install a custom app version in Make.com
+func (c *Client) DeleteCustomAppInvite(ctx context.Context, name string, version int64, organizationID string) error {
+	endpoint := fmt.Sprintf("v2/sdk/apps/%s/%d/invites/%s", name, version, organizationID)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already revoked or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// CustomFunctionResponse represents a Make.com custom IML function from the API
+type CustomFunctionResponse struct {
+	ID          ID     `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Code        string `json:"code"`
+	TeamID      ID     `json:"team_id,omitempty"`
+}
+
+// CustomFunctionRequest represents the request payload for creating/updating custom functions
+type CustomFunctionRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Code        string `json:"code"`
+	TeamID      string `json:"team_id,omitempty"`
+}
+
+// CreateCustomFunction creates a new custom function in Make.com
+func (c *Client) CreateCustomFunction(ctx context.Context, req CustomFunctionRequest) (*CustomFunctionResponse, error) {
+	resp, err := c.MakeRequest(ctx, "POST", "v2/functions", req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var function CustomFunctionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&function); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &function, nil
+}
+
+// GetCustomFunction retrieves a custom function by ID from Make.com
+func (c *Client) GetCustomFunction(ctx context.Context, id string) (*CustomFunctionResponse, error) {
+	endpoint := fmt.Sprintf("v2/functions/%s", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("custom function with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var function CustomFunctionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&function); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &function, nil
+}
+
+// UpdateCustomFunction updates an existing custom function in Make.com, sending only the fields
+// of req that differ from prior, the request matching its current state.
+// Without changes nothing is written and the custom function is only read.
+func (c *Client) UpdateCustomFunction(ctx context.Context, id string, prior, req CustomFunctionRequest) (*CustomFunctionResponse, error) {
+	endpoint := fmt.Sprintf("v2/functions/%s", id)
+	fields, err := patchFields(prior, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	if len(fields) == 0 {
+		tflog.Debug(ctx, "skipping Make API update without changes", map[string]interface{}{"endpoint": endpoint})
+		return c.GetCustomFunction(ctx, id)
+	}
+
+	resp, err := c.MakeRequest(ctx, "PATCH", endpoint, fields)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("custom function with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var function CustomFunctionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&function); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &function, nil
+}
+
+// DeleteCustomFunction deletes a custom function from Make.com
+func (c *Client) DeleteCustomFunction(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("v2/functions/%s", id)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// KeyResponse represents a keychain key from the Make.com API. The secret
+// parameters of a key are never returned.
+type KeyResponse struct {
+	ID       ID     `json:"id"`
+	Name     string `json:"name"`
+	TypeName string `json:"type_name"`
+	TeamID   ID     `json:"team_id,omitempty"`
+}
+
+// KeyRequest represents the request payload for creating and updating keys
+type KeyRequest struct {
+	Name       string            `json:"name"`
+	TypeName   string            `json:"type_name,omitempty"`
+	TeamID     string            `json:"team_id,omitempty"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// CreateKey creates a new keychain key in Make.com
+func (c *Client) CreateKey(ctx context.Context, req KeyRequest) (*KeyResponse, error) {
+	resp, err := c.MakeRequest(ctx, "POST", "v2/keys", req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var key KeyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&key); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &key, nil
+}
+
+// GetKey retrieves a keychain key by ID from Make.com
+func (c *Client) GetKey(ctx context.Context, id string) (*KeyResponse, error) {
+	endpoint := fmt.Sprintf("v2/keys/%s", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("key with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var key KeyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&key); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &key, nil
+}
+
+// UpdateKey updates an existing keychain key in Make.com. The parameters are
+// only replaced when given.
+func (c *Client) UpdateKey(ctx context.Context, id string, req KeyRequest) (*KeyResponse, error) {
+	endpoint := fmt.Sprintf("v2/keys/%s", id)
+	resp, err := c.MakeRequest(ctx, "PUT", endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("key with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var key KeyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&key); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &key, nil
+}
+
+// DeleteKey deletes a keychain key from Make.com
+func (c *Client) DeleteKey(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("v2/keys/%s", id)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// scimUserSchema is the SCIM core schema of user resources
+const scimUserSchema = "urn:ietf:params:scim:schemas:core:2.0:User"
+
+// ScimUserName represents the name of a SCIM user
+type ScimUserName struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// ScimEmail represents an email address of a SCIM user
+type ScimEmail struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+// ScimUser represents a user provisioned through the Make.com SCIM API
+type ScimUser struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	UserName    string       `json:"userName"`
+	Name        ScimUserName `json:"name"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Emails      []ScimEmail  `json:"emails,omitempty"`
+	Active      bool         `json:"active"`
+}
+
+// CreateScimUser provisions a new user through the Make.com SCIM API
+func (c *Client) CreateScimUser(ctx context.Context, user ScimUser) (*ScimUser, error) {
+	user.Schemas = []string{scimUserSchema}
+	resp, err := c.MakeRequest(ctx, "POST", "v2/scim/Users", user)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var created ScimUser
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetScimUser retrieves a SCIM user by ID from Make.com
+func (c *Client) GetScimUser(ctx context.Context, id string) (*ScimUser, error) {
+	endpoint := fmt.Sprintf("v2/scim/Users/%s", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("SCIM user with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var user ScimUser
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &user, nil
+}
+
+// UpdateScimUser replaces the attributes of a SCIM user in Make.com
+func (c *Client) UpdateScimUser(ctx context.Context, id string, user ScimUser) (*ScimUser, error) {
+	user.Schemas = []string{scimUserSchema}
+	endpoint := fmt.Sprintf("v2/scim/Users/%s", id)
+	resp, err := c.MakeRequest(ctx, "PUT", endpoint, user)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("SCIM user with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var updated ScimUser
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteScimUser deprovisions a SCIM user from Make.com
+func (c *Client) DeleteScimUser(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("v2/scim/Users/%s", id)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// scimGroupSchema is the SCIM core schema of group resources
+const scimGroupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+
+// scimGroupTeamSchema is the Make.com extension schema mapping groups to teams
+const scimGroupTeamSchema = "urn:ietf:params:scim:schemas:extension:make:2.0:Group"
+
+// ScimGroupMember represents a member of a SCIM group
+type ScimGroupMember struct {
+	Value string `json:"value"`
+}
+
+// ScimGroupTeam represents the team a SCIM group grants access to
+type ScimGroupTeam struct {
+	TeamID string `json:"teamId"`
+	Role   string `json:"role,omitempty"`
+}
+
+// ScimGroup represents a group provisioned through the Make.com SCIM API
+type ScimGroup struct {
+	Schemas     []string          `json:"schemas"`
+	ID          string            `json:"id,omitempty"`
+	ExternalID  string            `json:"externalId,omitempty"`
+	DisplayName string            `json:"displayName"`
+	Members     []ScimGroupMember `json:"members"`
+	Team        *ScimGroupTeam    `json:"urn:ietf:params:scim:schemas:extension:make:2.0:Group,omitempty"`
+}
+
+// scimGroupSchemas returns the schemas the group is made of
+func scimGroupSchemas(group ScimGroup) []string {
+	if group.Team != nil {
+		return []string{scimGroupSchema, scimGroupTeamSchema}
+	}
+	return []string{scimGroupSchema}
+}
+
+// CreateScimGroup provisions a new group through the Make.com SCIM API
+func (c *Client) CreateScimGroup(ctx context.Context, group ScimGroup) (*ScimGroup, error) {
+	group.Schemas = scimGroupSchemas(group)
+	resp, err := c.MakeRequest(ctx, "POST", "v2/scim/Groups", group)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var created ScimGroup
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetScimGroup retrieves a SCIM group by ID from Make.com
+func (c *Client) GetScimGroup(ctx context.Context, id string) (*ScimGroup, error) {
+	endpoint := fmt.Sprintf("v2/scim/Groups/%s", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("SCIM group with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var group ScimGroup
+	if err := json.NewDecoder(resp.Body).Decode(&group); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &group, nil
+}
+
+// UpdateScimGroup replaces the attributes and members of a SCIM group in Make.com
+func (c *Client) UpdateScimGroup(ctx context.Context, id string, group ScimGroup) (*ScimGroup, error) {
+	group.Schemas = scimGroupSchemas(group)
+	endpoint := fmt.Sprintf("v2/scim/Groups/%s", id)
+	resp, err := c.MakeRequest(ctx, "PUT", endpoint, group)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("SCIM group with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var updated ScimGroup
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteScimGroup deprovisions a SCIM group from Make.com
+func (c *Client) DeleteScimGroup(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("v2/scim/Groups/%s", id)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// OrganizationIPAllowlist represents the IP ranges allowed to access an
+// organization. An empty list allows access from anywhere.
+type OrganizationIPAllowlist struct {
+	IPRanges []string `json:"ip_ranges"`
+}
+
+// GetOrganizationIPAllowlist retrieves the IP allowlist of an organization from Make.com
+func (c *Client) GetOrganizationIPAllowlist(ctx context.Context, organizationID string) (*OrganizationIPAllowlist, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/ip-allowlist", organizationID)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("organization with ID %s not found", organizationID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var allowlist OrganizationIPAllowlist
+	if err := json.NewDecoder(resp.Body).Decode(&allowlist); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &allowlist, nil
+}
+
+// SetOrganizationIPAllowlist replaces the IP allowlist of an organization in Make.com
+func (c *Client) SetOrganizationIPAllowlist(ctx context.Context, organizationID string, allowlist OrganizationIPAllowlist) (*OrganizationIPAllowlist, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/ip-allowlist", organizationID)
+	resp, err := c.MakeRequest(ctx, "PUT", endpoint, allowlist)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("organization with ID %s not found", organizationID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var updated OrganizationIPAllowlist
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// auditLogsPageSize is the number of audit log entries requested per page
+const auditLogsPageSize = 100
+
+// AuditLogEntry represents an entry of the organization audit log from the Make.com API
+type AuditLogEntry struct {
+	ID         ID     `json:"id"`
+	ActorID    ID     `json:"actor_id"`
+	ActorName  string `json:"actor_name"`
+	Action     string `json:"action"`
+	EntityType string `json:"entity_type"`
+	EntityID   ID     `json:"entity_id"`
+	EntityName string `json:"entity_name"`
+	TeamID     ID     `json:"team_id,omitempty"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// AuditLogFilter narrows down the audit log entries returned by ListAuditLogs.
+// Empty fields are not filtered on.
+type AuditLogFilter struct {
+	ActorID    string
+	Action     string
+	EntityType string
+	EntityID   string
+	From       string
+	To         string
+}
+
+// auditLogsEndpoint builds the endpoint of a page of the audit log
+func auditLogsEndpoint(organizationID string, filter AuditLogFilter, offset, limit int) string {
+	query := url.Values{}
+	for key, value := range map[string]string{
+		"actor_id":    filter.ActorID,
+		"action":      filter.Action,
+		"entity_type": filter.EntityType,
+		"entity_id":   filter.EntityID,
+		"from":        filter.From,
+		"to":          filter.To,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	query.Set("pg[offset]", strconv.Itoa(offset))
+	query.Set("pg[limit]", strconv.Itoa(limit))
+
+	return fmt.Sprintf("v2/organizations/%s/audit-logs?%s", organizationID, query.Encode())
+}
+
+// ListAuditLogs retrieves the audit log entries of an organization matching
+// the filter, newest first, following pagination until maxEntries entries
+// were read. A maxEntries of zero reads all matching entries.
+func (c *Client) ListAuditLogs(ctx context.Context, organizationID string, filter AuditLogFilter, maxEntries int) ([]AuditLogEntry, error) {
+	var entries []AuditLogEntry
+
+	for {
+		pageSize := auditLogsPageSize
+		if maxEntries > 0 && maxEntries-len(entries) < pageSize {
+			pageSize = maxEntries - len(entries)
+		}
+
+		resp, err := c.MakeRequest(ctx, "GET", auditLogsEndpoint(organizationID, filter, len(entries), pageSize), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == 404 {
+			_ = resp.Body.Close()
+			return nil, notFoundError("organization with ID %s not found", organizationID)
+		}
+
+		if resp.StatusCode >= 400 {
+			return nil, c.HandleErrorResponse(resp)
+		}
+
+		var result struct {
+			AuditLogs []AuditLogEntry `json:"audit_logs"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		entries = append(entries, result.AuditLogs...)
+
+		// A short page is the last one
+		if len(result.AuditLogs) < pageSize || (maxEntries > 0 && len(entries) >= maxEntries) {
+			return entries, nil
+		}
+	}
+}
+
+// AuditLogExport represents the audit log export configuration of an
+// organization. The token and headers are never returned by the API.
+type AuditLogExport struct {
+	DestinationType string            `json:"destination_type"`
+	URL             string            `json:"url"`
+	Token           string            `json:"token,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	Enabled         bool              `json:"enabled"`
+}
+
+// GetAuditLogExport retrieves the audit log export configuration of an organization from Make.com
+func (c *Client) GetAuditLogExport(ctx context.Context, organizationID string) (*AuditLogExport, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/audit-log-export", organizationID)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("audit log export of organization with ID %s not found", organizationID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var export AuditLogExport
+	if err := json.NewDecoder(resp.Body).Decode(&export); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &export, nil
+}
+
+// SetAuditLogExport creates or replaces the audit log export configuration of an organization in Make.com
+func (c *Client) SetAuditLogExport(ctx context.Context, organizationID string, export AuditLogExport) (*AuditLogExport, error) {
+	endpoint := fmt.Sprintf("v2/organizations/%s/audit-log-export", organizationID)
+	resp, err := c.MakeRequest(ctx, "PUT", endpoint, export)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("organization with ID %s not found", organizationID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var updated AuditLogExport
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteAuditLogExport stops the audit log export of an organization in Make.com
+func (c *Client) DeleteAuditLogExport(ctx context.Context, organizationID string) error {
+	endpoint := fmt.Sprintf("v2/organizations/%s/audit-log-export", organizationID)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already deleted or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// NotificationPreferences represents the scenario notification preferences
+// of a team or user. Nil and empty fields are left unchanged on update.
+type NotificationPreferences struct {
+	ScenarioErrors        *bool  `json:"scenario_errors,omitempty"`
+	ScenarioWarnings      *bool  `json:"scenario_warnings,omitempty"`
+	ScenarioDeactivations *bool  `json:"scenario_deactivations,omitempty"`
+	Frequency             string `json:"frequency,omitempty"`
+}
+
+// notificationPreferencesEndpoint returns the endpoint of the notification
+// preferences of a team, or of the authenticated user when teamID is empty
+func notificationPreferencesEndpoint(teamID string) string {
+	if teamID == "" {
+		return "v2/users/me/notification-preferences"
+	}
+	return fmt.Sprintf("v2/teams/%s/notification-preferences", teamID)
+}
+
+// GetNotificationPreferences retrieves the notification preferences of a team
+// or of the authenticated user from Make.com
+func (c *Client) GetNotificationPreferences(ctx context.Context, teamID string) (*NotificationPreferences, error) {
+	resp, err := c.MakeRequest(ctx, "GET", notificationPreferencesEndpoint(teamID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("team with ID %s not found", teamID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var preferences NotificationPreferences
+	if err := json.NewDecoder(resp.Body).Decode(&preferences); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &preferences, nil
+}
+
+// UpdateNotificationPreferences updates the notification preferences of a
+// team or of the authenticated user in Make.com
+func (c *Client) UpdateNotificationPreferences(ctx context.Context, teamID string, preferences NotificationPreferences) (*NotificationPreferences, error) {
+	resp, err := c.MakeRequest(ctx, "PUT", notificationPreferencesEndpoint(teamID), preferences)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("team with ID %s not found", teamID)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var updated NotificationPreferences
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// AppResponse represents an app available in Make.com from the API
+type AppResponse struct {
+	Name    string `json:"name"`
+	Label   string `json:"label"`
+	Version int64  `json:"version"`
+	Beta    bool   `json:"beta"`
+}
+
+// ListApps retrieves the apps available in Make.com
+func (c *Client) ListApps(ctx context.Context) ([]AppResponse, error) {
+	return listAll[AppResponse](ctx, c, "v2/apps", "apps", listOptions{})
+}
+
+// AppModuleResponse represents a module of a Make.com app from the API
+type AppModuleResponse struct {
+	Name        string          `json:"name"`
+	Label       string          `json:"label"`
+	Type        string          `json:"type"`
+	Description string          `json:"description,omitempty"`
+	Parameters  json.RawMessage `json:"parameters,omitempty"`
+	Interface   json.RawMessage `json:"interface,omitempty"`
+}
+
+// ListAppModules retrieves the modules of a version of an app from Make.com
+func (c *Client) ListAppModules(ctx context.Context, appName string, appVersion int64) ([]AppModuleResponse, error) {
+	endpoint := fmt.Sprintf("v2/apps/%s/%d/modules", appName, appVersion)
+	return listAll[AppModuleResponse](ctx, c, endpoint, "modules", listOptions{
+		NotFound: fmt.Sprintf("app %s version %d not found", appName, appVersion),
+	})
+}
+
+// AppConnectionResponse describes the connection of a Make.com app from the
+// apps catalog, with the parameters a connection of the app takes
+type AppConnectionResponse struct {
+	Name       string         `json:"name"`
+	Label      string         `json:"label"`
+	Parameters []AppParameter `json:"parameters"`
+}
+
+// AppParameter describes a parameter of a Make.com app, e.g. the url of an
+// HTTP connection. Type is one of the parameter types of Make, such as text,
+// password, number, boolean, select, array or collection.
+type AppParameter struct {
+	Name     string               `json:"name"`
+	Label    string               `json:"label,omitempty"`
+	Type     string               `json:"type"`
+	Required bool                 `json:"required,omitempty"`
+	Options  []AppParameterOption `json:"options,omitempty"`
+}
+
+// AppParameterOption is an allowed value of a select parameter
+type AppParameterOption struct {
+	Label string `json:"label,omitempty"`
+	Value string `json:"value"`
+}
+
+// GetAppConnection retrieves the connection of an app from the apps catalog
+// of Make.com
+func (c *Client) GetAppConnection(ctx context.Context, appName string) (*AppConnectionResponse, error) {
+	endpoint := fmt.Sprintf("v2/apps/%s/connection", appName)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("connection of app %s not found", appName)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var result struct {
+		Connection AppConnectionResponse `json:"connection"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result.Connection, nil
+}
+
+// UserResponse represents the authenticated Make.com user from the API
+type UserResponse struct {
+	ID    ID     `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// AuthorizationResponse describes the authorization of the API token in use
+type AuthorizationResponse struct {
+	Scopes []string `json:"scopes"`
+}
+
+// GetCurrentUser retrieves the user the API token belongs to
+func (c *Client) GetCurrentUser(ctx context.Context) (*UserResponse, error) {
+	resp, err := c.MakeRequest(ctx, "GET", "v2/users/me", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var user UserResponse
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &user, nil
+}
+
+// GetCurrentAuthorization retrieves the scopes granted to the API token in use
+func (c *Client) GetCurrentAuthorization(ctx context.Context) (*AuthorizationResponse, error) {
+	resp, err := c.MakeRequest(ctx, "GET", "v2/users/me/current-authorization", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var authorization AuthorizationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&authorization); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &authorization, nil
+}
+
+// APITokenResponse represents a Make.com API token from the API. Token is only
+// returned when the token is created.
+type APITokenResponse struct {
+	ID        ID       `json:"id"`
+	Label     string   `json:"label"`
+	Scopes    []string `json:"scopes"`
+	Token     string   `json:"token,omitempty"`
+	CreatedAt string   `json:"created_at,omitempty"`
+}
+
+// APITokenRequest represents the request payload for creating API tokens
+type APITokenRequest struct {
+	Label  string   `json:"label"`
+	Scopes []string `json:"scopes"`
+}
+
+// CreateAPIToken creates a new API token for the authenticated user in Make.com
+func (c *Client) CreateAPIToken(ctx context.Context, req APITokenRequest) (*APITokenResponse, error) {
+	resp, err := c.MakeRequest(ctx, "POST", "v2/users/me/api-tokens", req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var token APITokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &token, nil
+}
+
+// GetAPIToken retrieves an API token of the authenticated user by ID from Make.com
+func (c *Client) GetAPIToken(ctx context.Context, id string) (*APITokenResponse, error) {
+	endpoint := fmt.Sprintf("v2/users/me/api-tokens/%s", id)
+	resp, err := c.MakeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		return nil, notFoundError("API token with ID %s not found", id)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, c.HandleErrorResponse(resp)
+	}
+
+	var token APITokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &token, nil
+}
+
+// DeleteAPIToken revokes an API token of the authenticated user in Make.com
+func (c *Client) DeleteAPIToken(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("v2/users/me/api-tokens/%s", id)
+	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 404 {
+		// Already revoked or doesn't exist
+		return nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return c.HandleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// UserRoleResponse represents a Make.com user role from the API
+type UserRoleResponse struct {
+	ID          ID       `json:"id"`
+	Name        string   `json:"name"`
+	Category    string   `json:"category"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// ListUserRoles retrieves the user roles available in Make.com
+func (c *Client) ListUserRoles(ctx context.Context) ([]UserRoleResponse, error) {
+	return listAll[UserRoleResponse](ctx, c, "v2/users/roles", "users_roles", listOptions{})
+}
+
+// Zone returns the Make zone the client talks to, i.e. the host of its base URL
+// (e.g. "eu1.make.com")
+func (c *Client) Zone() string {
+	u, err := url.Parse(c.BaseUrl)
+	if err != nil {
+		return ""
+	}
+
+	return u.Hostname()
+}
+
+// BaseURL returns the base URL of the API the client talks to
+func (c *Client) BaseURL() string {
+	return c.BaseUrl
+}
+
+// MaxWorkers returns how many requests a single call of the client fans out
+// to at once, for callers fanning out requests themselves
+func (c *Client) MaxWorkers() int {
+	return max(c.Workers, 1)
+}
@@ -0,0 +1,1202 @@
+package makeapi
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_MakeRequest(t *testing.T) {
+	client := &Client{
+		ApiToken: "test-token",
+		BaseUrl:  "https://api.make.com/",
+	}
+
+	// Test URL construction
+	ctx := context.Background()
+
+	// This would normally hit the API, but we can test URL construction
+	req := ScenarioRequest{
+		Name:   "Test Scenario",
+		Active: true,
+	}
+
+	// Basic test that client can construct requests
+	if client.ApiToken != "test-token" {
+		t.Errorf("Expected ApiToken to be 'test-token', got %s", client.ApiToken)
+	}
+
+	if client.BaseUrl != "https://api.make.com/" {
+		t.Errorf("Expected BaseUrl to be 'https://api.make.com/', got %s", client.BaseUrl)
+	}
+
+	// Test that request structure is correct
+	if req.Name != "Test Scenario" {
+		t.Errorf("Expected Name to be 'Test Scenario', got %s", req.Name)
+	}
+
+	// Suppress unused variable warning
+	_ = ctx
+}
+
+func TestClientZone(t *testing.T) {
+	testCases := map[string]string{
+		"https://eu1.make.com/api/": "eu1.make.com",
+		"https://api.make.com/":     "api.make.com",
+		"http://localhost:8080/":    "localhost",
+	}
+
+	for baseUrl, want := range testCases {
+		client := &Client{BaseUrl: baseUrl}
+		if got := client.Zone(); got != want {
+			t.Errorf("Expected zone of %s to be '%s', got '%s'", baseUrl, want, got)
+		}
+	}
+}
+
+func TestClientMaxWorkers(t *testing.T) {
+	testCases := map[int]int{
+		0: 1,
+		1: 1,
+		8: 8,
+	}
+
+	for workers, want := range testCases {
+		client := &Client{Workers: workers}
+		if got := client.MaxWorkers(); got != want {
+			t.Errorf("Expected %d workers for Workers %d, got %d", want, workers, got)
+		}
+	}
+}
+
+func TestAPIPathPrefix(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"teams": []TeamResponse{}})
+	}))
+	defer server.Close()
+
+	testCases := map[string]string{
+		"":        "/api/v2/teams",
+		"rest/v2": "/api/rest/v2/teams",
+		"v1":      "/api/v1/teams",
+	}
+
+	for prefix, want := range testCases {
+		paths = nil
+		client := &Client{BaseUrl: server.URL + "/api/", APIPathPrefix: prefix, HTTPClient: server.Client()}
+
+		if _, err := client.ListTeams(context.Background(), "42"); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if len(paths) != 1 || paths[0] != want {
+			t.Errorf("Expected path %s for prefix %q, got %v", want, prefix, paths)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	baseDelay := retryBaseDelay
+	retryBaseDelay = time.Millisecond
+	defer func() { retryBaseDelay = baseDelay }()
+
+	testCases := map[string]struct {
+		method       string
+		status       int
+		failures     int
+		wantStatus   int
+		wantRequests int
+	}{
+		"recovers from server errors":       {method: "PUT", status: http.StatusServiceUnavailable, failures: 2, wantStatus: http.StatusOK, wantRequests: 3},
+		"recovers from PATCH server errors": {method: "PATCH", status: http.StatusBadGateway, failures: 1, wantStatus: http.StatusOK, wantRequests: 2},
+		"recovers from rate limiting":       {method: "POST", status: http.StatusTooManyRequests, failures: 1, wantStatus: http.StatusOK, wantRequests: 2},
+		"gives up after max retries":        {method: "DELETE", status: http.StatusBadGateway, failures: 5, wantStatus: http.StatusBadGateway, wantRequests: 3},
+		"does not retry POST server errors": {method: "POST", status: http.StatusServiceUnavailable, failures: 1, wantStatus: http.StatusServiceUnavailable, wantRequests: 1},
+		"does not retry 501":                {method: "PUT", status: http.StatusNotImplemented, failures: 1, wantStatus: http.StatusNotImplemented, wantRequests: 1},
+		"does not retry client error":       {method: "PUT", status: http.StatusBadRequest, failures: 1, wantStatus: http.StatusBadRequest, wantRequests: 1},
+	}
+
+	for name, testCase := range testCases {
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			bodies = append(bodies, body["name"])
+
+			if len(bodies) <= testCase.failures {
+				w.WriteHeader(testCase.status)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		client := &Client{BaseUrl: server.URL, HTTPClient: server.Client(), MaxRetries: 2}
+
+		resp, err := client.MakeRequest(context.Background(), testCase.method, "v2/teams", map[string]string{"name": "Team"})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+		_ = resp.Body.Close()
+		server.Close()
+
+		if resp.StatusCode != testCase.wantStatus {
+			t.Errorf("%s: expected status %d, got %d", name, testCase.wantStatus, resp.StatusCode)
+		}
+
+		if len(bodies) != testCase.wantRequests {
+			t.Errorf("%s: expected %d requests, got %d", name, testCase.wantRequests, len(bodies))
+		}
+
+		// Every attempt resends the request body
+		for _, body := range bodies {
+			if body != "Team" {
+				t.Errorf("%s: expected request body to be resent, got %q", name, body)
+			}
+		}
+	}
+}
+
+func TestRetryConnectError(t *testing.T) {
+	baseDelay := retryBaseDelay
+	retryBaseDelay = time.Millisecond
+	defer func() { retryBaseDelay = baseDelay }()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	testCases := map[string]struct {
+		err          error
+		wantErr      bool
+		wantAttempts int
+	}{
+		"retries requests that could not connect": {
+			err:          &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+			wantAttempts: 2,
+		},
+		"does not retry requests failing once sent": {
+			err:          &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		attempts := 0
+		transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts == 1 {
+				return nil, testCase.err
+			}
+			return http.DefaultTransport.RoundTrip(req)
+		})
+
+		client := &Client{BaseUrl: server.URL, HTTPClient: &http.Client{Transport: transport}, MaxRetries: 2}
+
+		resp, err := client.MakeRequest(context.Background(), "POST", "v2/teams", map[string]string{"name": "Team"})
+		if testCase.wantErr != (err != nil) {
+			t.Errorf("%s: expected error %t, got %v", name, testCase.wantErr, err)
+		}
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+
+		if attempts != testCase.wantAttempts {
+			t.Errorf("%s: expected %d attempts, got %d", name, testCase.wantAttempts, attempts)
+		}
+	}
+}
+
+// roundTripperFunc adapts a function to an http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	for retry := range 20 {
+		delay := retryBackoff(retry)
+		ceiling := retryMaxDelay
+		if retry < 5 {
+			ceiling = min(retryBaseDelay<<retry, retryMaxDelay)
+		}
+
+		if delay < ceiling/2 || delay > ceiling {
+			t.Errorf("Expected delay of retry %d between %s and %s, got %s", retry, ceiling/2, ceiling, delay)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		header map[string]string
+		want   time.Duration
+		ok     bool
+	}{
+		"seconds":         {header: map[string]string{"Retry-After": "7"}, want: 7 * time.Second, ok: true},
+		"date":            {header: map[string]string{"Retry-After": now.Add(time.Minute).Format(http.TimeFormat)}, want: time.Minute, ok: true},
+		"past date":       {header: map[string]string{"Retry-After": now.Add(-time.Minute).Format(http.TimeFormat)}, want: 0, ok: true},
+		"reset seconds":   {header: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "30"}, want: 30 * time.Second, ok: true},
+		"reset timestamp": {header: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": strconv.FormatInt(now.Unix()+45, 10)}, want: 45 * time.Second, ok: true},
+		"quota left":      {header: map[string]string{"X-RateLimit-Remaining": "3", "X-RateLimit-Reset": "30"}},
+		"invalid":         {header: map[string]string{"Retry-After": "soon"}},
+		"none":            {},
+	}
+
+	for name, testCase := range testCases {
+		resp := &http.Response{Header: http.Header{}}
+		for key, value := range testCase.header {
+			resp.Header.Set(key, value)
+		}
+
+		got, ok := retryAfter(resp, now)
+		if ok != testCase.ok || got != testCase.want {
+			t.Errorf("%s: expected %s, %t, got %s, %t", name, testCase.want, testCase.ok, got, ok)
+		}
+	}
+}
+
+func TestRetryRateLimited(t *testing.T) {
+	testCases := map[string]struct {
+		retryAfter   string
+		wantRequests int
+		wantError    string
+	}{
+		"waits for the rate limit": {retryAfter: "0", wantRequests: 2},
+		"gives up on long waits":   {retryAfter: "3600", wantRequests: 1, wantError: "API rate limit exceeded (status 429), retry after 1h0m0s: Too many requests (GET /v2/teams)"},
+	}
+
+	for name, testCase := range testCases {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("X-RateLimit-Limit", "60")
+			if requests == 1 {
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("Retry-After", testCase.retryAfter)
+				w.WriteHeader(http.StatusTooManyRequests)
+				_ = json.NewEncoder(w).Encode(ErrorResponse{Message: "Too many requests"})
+				return
+			}
+			w.Header().Set("X-RateLimit-Remaining", "59")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"teams": []TeamResponse{}})
+		}))
+
+		client := &Client{BaseUrl: server.URL, HTTPClient: server.Client(), MaxRetries: 3}
+
+		_, err := client.ListTeams(context.Background(), "42")
+		server.Close()
+
+		if testCase.wantError == "" && err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+		}
+		if testCase.wantError != "" && (err == nil || err.Error() != testCase.wantError) {
+			t.Errorf("%s: expected error %q, got %v", name, testCase.wantError, err)
+		}
+
+		if requests != testCase.wantRequests {
+			t.Errorf("%s: expected %d requests, got %d", name, testCase.wantRequests, requests)
+		}
+	}
+}
+
+func TestOperationTimeout(t *testing.T) {
+	testCases := map[string]struct {
+		handler     http.HandlerFunc
+		ctxTimeout  time.Duration
+		wantTimeout bool
+	}{
+		"hung endpoint": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				<-r.Context().Done()
+			},
+			wantTimeout: true,
+		},
+		"retried server errors": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			wantTimeout: true,
+		},
+		"fast response": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(TeamResponse{ID: "7", Name: "Automation"})
+			},
+		},
+		"slow response within the timeout of the context": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(200 * time.Millisecond)
+				_ = json.NewEncoder(w).Encode(TeamResponse{ID: "7", Name: "Automation"})
+			},
+			ctxTimeout: time.Second,
+		},
+		"hung endpoint with the timeout of the context": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				<-r.Context().Done()
+			},
+			ctxTimeout:  50 * time.Millisecond,
+			wantTimeout: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		server := httptest.NewServer(testCase.handler)
+
+		client := &Client{BaseUrl: server.URL, HTTPClient: server.Client(), MaxRetries: 3, OperationTimeout: 100 * time.Millisecond}
+
+		// Without a timeout of the context, each call is bounded by OperationTimeout
+		ctx, cancel := WithOperationTimeout(context.Background(), testCase.ctxTimeout)
+
+		// The body of a response stays readable after the call returns
+		team, err := client.GetTeam(ctx, "7")
+		cancel()
+		server.Close()
+
+		var timeout *TimeoutError
+		if errors.As(err, &timeout) != testCase.wantTimeout {
+			t.Errorf("%s: expected timeout=%t, got error: %v", name, testCase.wantTimeout, err)
+		}
+		if testCase.wantTimeout && !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("%s: expected the error to match context.DeadlineExceeded, got %v", name, err)
+		}
+		if !testCase.wantTimeout && (err != nil || team.Name != "Automation") {
+			t.Errorf("%s: expected team Automation, got %+v: %v", name, team, err)
+		}
+	}
+}
+
+func TestRequestLimiter(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		peak = max(peak, inFlight)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	client := &Client{BaseUrl: server.URL, HTTPClient: server.Client(), Limiter: NewRequestLimiter(2)}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.MakeRequest(context.Background(), "GET", "v2/teams", nil)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			_ = resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("Expected at most 2 concurrent requests, got %d", peak)
+	}
+
+	// Waiting for a slot ends with the context
+	full := NewRequestLimiter(1)
+	_ = full.acquire(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := full.acquire(ctx); err == nil {
+		t.Errorf("Expected an error acquiring a slot with a canceled context")
+	}
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	bundle := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	invalid := filepath.Join(dir, "invalid.pem")
+	if err := os.WriteFile(invalid, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		config    TransportConfig
+		wantError bool
+		trusted   bool
+	}{
+		"system CAs":     {config: TransportConfig{TLSMinVersion: TLSVersions["1.2"]}},
+		"CA bundle":      {config: TransportConfig{TLSMinVersion: TLSVersions["1.2"], CABundleFile: bundle}, trusted: true},
+		"missing bundle": {config: TransportConfig{CABundleFile: filepath.Join(dir, "missing.pem")}, wantError: true},
+		"invalid bundle": {config: TransportConfig{CABundleFile: invalid}, wantError: true},
+	}
+
+	for name, testCase := range testCases {
+		client, err := NewHTTPClient(testCase.config)
+		if testCase.wantError {
+			if err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+
+		resp, err := client.Get(server.URL)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		if (err == nil) != testCase.trusted {
+			t.Errorf("%s: expected trusted=%t, got error: %v", name, testCase.trusted, err)
+		}
+	}
+}
+
+func TestProxyURL(t *testing.T) {
+	var hosts, credentials []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.URL.Host)
+		credentials = append(credentials, r.Header.Get("Proxy-Authorization"))
+	}))
+	defer proxy.Close()
+
+	proxyURL := strings.Replace(proxy.URL, "http://", "http://user:secret@", 1)
+	client, err := NewHTTPClient(TransportConfig{ProxyURL: proxyURL})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	resp, err := client.Get("http://eu1.make.test/api/v2/users/me")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	_ = resp.Body.Close()
+
+	wantCredentials := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:secret"))
+	if len(hosts) != 1 || hosts[0] != "eu1.make.test" || credentials[0] != wantCredentials {
+		t.Errorf("Expected the request to eu1.make.test to pass the authenticated proxy, got hosts %v and credentials %v", hosts, credentials)
+	}
+
+	for _, invalid := range []string{"ftp://proxy.example.com", "proxy.example.com:3128", "http://"} {
+		if _, err := NewHTTPClient(TransportConfig{ProxyURL: invalid}); err == nil {
+			t.Errorf("Expected an error for proxy URL %q", invalid)
+		}
+	}
+
+	if _, err := NewHTTPClient(TransportConfig{ClientCertificateFile: "client.pem"}); err == nil {
+		t.Errorf("Expected an error for a client certificate without key")
+	}
+}
+
+func TestCustomHeaders(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+	}))
+	defer server.Close()
+
+	client := &Client{
+		ApiToken:   "token",
+		BaseUrl:    server.URL,
+		HTTPClient: server.Client(),
+		CustomHeaders: map[string]string{
+			"X-Tenant-ID":   "acme",
+			"Authorization": "Basic Z2F0ZXdheQ==",
+		},
+	}
+
+	resp, err := client.MakeRequest(context.Background(), "GET", "v2/teams", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	_ = resp.Body.Close()
+
+	if got := header.Get("X-Tenant-ID"); got != "acme" {
+		t.Errorf("Expected X-Tenant-ID header acme, got %q", got)
+	}
+	if got := header.Get("Authorization"); got != "Token token" {
+		t.Errorf("Expected the provider Authorization header to be kept, got %q", got)
+	}
+}
+
+func TestDebugTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(TransportConfig{Debug: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := client.Transport.(*debugTransport); !ok {
+		t.Fatalf("expected the debug transport, got %T", client.Transport)
+	}
+
+	// Bodies reach the server and the caller unchanged by logging
+	sent := `{"name":"Slack","settings":{"apiKey":"secret-key"}}`
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(sent))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	received, _ := io.ReadAll(resp.Body)
+	if string(received) != sent {
+		t.Errorf("expected body %s, got %s", sent, received)
+	}
+
+	testCases := map[string]struct {
+		urlPath     string
+		contentType string
+		body        string
+		want        string
+	}{
+		"connection settings": {
+			urlPath:     "/api/v2/connections",
+			contentType: "application/json",
+			body:        `{"name":"Slack","settings":{"apiKey":"k","client_secret":"s","accessToken":"t","channel":"general"}}`,
+			want:        `{"name":"Slack","settings":{"accessToken":"REDACTED","apiKey":"REDACTED","channel":"general","client_secret":"REDACTED"}}`,
+		},
+		"key parameters": {
+			urlPath:     "/api/v2/keys",
+			contentType: "application/json; charset=utf-8",
+			body:        `[{"type_name":"aes-key","parameters":{"key":"00ff"}}]`,
+			want:        `[{"parameters":{"key":"REDACTED"},"type_name":"aes-key"}]`,
+		},
+		"key update": {
+			urlPath:     "/api/v2/keys/12",
+			contentType: "application/json",
+			body:        `{"name":"Signing","parameters":{"username":"ci","passphrase":"p"}}`,
+			want:        `{"name":"Signing","parameters":{"passphrase":"REDACTED","username":"REDACTED"}}`,
+		},
+		"audit log export headers": {
+			urlPath:     "/api/v2/organizations/3/audit-log-export",
+			contentType: "application/json",
+			body:        `{"url":"https://siem.example.com","headers":{"X-Splunk-Request-Channel":"c"}}`,
+			want:        `{"headers":{"X-Splunk-Request-Channel":"REDACTED"},"url":"https://siem.example.com"}`,
+		},
+		"webhook response headers": {
+			urlPath:     "/api/v2/hooks/5",
+			contentType: "application/json",
+			body:        `{"response":{"headers":{"Content-Type":"text/plain"}}}`,
+			want:        `{"response":{"headers":{"Content-Type":"text/plain"}}}`,
+		},
+		"secret variable": {
+			urlPath:     "/api/v2/teams/7/variables",
+			contentType: "application/json",
+			body:        `[{"name":"region","value":"eu","is_secret":false},{"name":"signing","value":"s","is_secret":true}]`,
+			want:        `[{"is_secret":false,"name":"region","value":"eu"},{"is_secret":true,"name":"signing","value":"REDACTED"}]`,
+		},
+		"oauth form": {
+			urlPath:     "/oauth/token",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "client_id=id&client_secret=s&grant_type=client_credentials",
+			want:        "client_id=id&client_secret=REDACTED&grant_type=client_credentials",
+		},
+		"plain text": {
+			urlPath:     "/api/v2/teams",
+			contentType: "text/plain",
+			body:        "Bad Gateway",
+			want:        "Bad Gateway",
+		},
+	}
+
+	for name, testCase := range testCases {
+		if got := redactBody(testCase.urlPath, testCase.contentType, []byte(testCase.body)); got != testCase.want {
+			t.Errorf("%s: expected %s, got %s", name, testCase.want, got)
+		}
+	}
+
+	header := http.Header{"Authorization": {"Token secret"}, "Accept": {"application/json"}}
+	redacted := redactHeaders(header)
+	if redacted["Authorization"] != RedactedValue || redacted["Accept"] != "application/json" {
+		t.Errorf("unexpected redacted headers %v", redacted)
+	}
+	if header.Get("Authorization") != "Token secret" {
+		t.Error("redacting changed the request headers")
+	}
+}
+
+func TestTypedErrors(t *testing.T) {
+	testCases := map[string]struct {
+		status  int
+		body    string
+		check   func(error) bool
+		message string
+	}{
+		"not found": {
+			status:  http.StatusNotFound,
+			check:   IsNotFound,
+			message: "team with ID 7 not found",
+		},
+		"unauthorized": {
+			status:  http.StatusUnauthorized,
+			body:    `{"message":"Invalid token"}`,
+			check:   func(err error) bool { var target *AuthError; return errors.As(err, &target) },
+			message: "authentication failed (status 401): the API token or OAuth client is invalid, expired or revoked: Invalid token (GET /v2/teams/7, request ID req-123)",
+		},
+		"forbidden": {
+			status: http.StatusForbidden,
+			body:   "<html>Forbidden</html>",
+			check: func(err error) bool {
+				var target *AuthError
+				return errors.As(err, &target) && target.Scope == "teams:read"
+			},
+			message: "access denied (status 403): the token lacks the teams:read scope required by this endpoint, or the user lacks permission for the object (GET /v2/teams/7, request ID req-123)",
+		},
+		"rate limited": {
+			status:  http.StatusTooManyRequests,
+			body:    `{"message":"Too many requests"}`,
+			check:   func(err error) bool { var target *RateLimitedError; return errors.As(err, &target) },
+			message: "API rate limit exceeded (status 429): Too many requests (GET /v2/teams/7, request ID req-123)",
+		},
+		"validation": {
+			status: http.StatusBadRequest,
+			body:   `{"message":"Invalid parameters","suberrors":[{"message":"must not be empty","path":"name"}]}`,
+			check: func(err error) bool {
+				var target *ValidationError
+				return errors.As(err, &target) && len(target.Fields) == 1 && target.Fields[0].Field == "name"
+			},
+			message: "API request failed with status 400: Invalid parameters (name: must not be empty) (GET /v2/teams/7, request ID req-123)",
+		},
+		"server error": {
+			status:  http.StatusNotImplemented,
+			body:    "not implemented",
+			check:   func(err error) bool { var target *APIError; return errors.As(err, &target) },
+			message: "API request failed with status 501: not implemented (GET /v2/teams/7, request ID req-123)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req-123")
+			w.WriteHeader(testCase.status)
+			_, _ = w.Write([]byte(testCase.body))
+		}))
+
+		client := &Client{BaseUrl: server.URL, HTTPClient: server.Client()}
+
+		_, err := client.GetTeam(context.Background(), "7")
+		server.Close()
+
+		if err == nil || !testCase.check(err) {
+			t.Errorf("%s: unexpected error type %T: %v", name, err, err)
+			continue
+		}
+
+		if err.Error() != testCase.message {
+			t.Errorf("%s: expected message %q, got %q", name, testCase.message, err.Error())
+		}
+	}
+}
+
+func TestRequiredScope(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		path   string
+		want   string
+	}{
+		"read team":            {method: "GET", path: "/api/v2/teams/7", want: "teams:read"},
+		"write scenario":       {method: "PATCH", path: "/api/v2/scenarios/3", want: "scenarios:write"},
+		"team variables":       {method: "POST", path: "/api/v2/teams/7/variables", want: "team-variables:write"},
+		"organization members": {method: "GET", path: "/api/v2/organizations/1/users", want: "organizations:read"},
+		"data structures":      {method: "GET", path: "/api/v2/teams/7/data-structures", want: "udts:read"},
+		"custom app":           {method: "DELETE", path: "/api/v2/sdk/apps/my-app/1", want: "sdk-apps:write"},
+		"path prefix":          {method: "GET", path: "/rest/v2/webhooks/5/logs", want: "hooks:read"},
+		"unknown":              {method: "GET", path: "/api/v2/unknown", want: ""},
+	}
+
+	for name, testCase := range testCases {
+		if got := requiredScope(testCase.method, testCase.path); got != testCase.want {
+			t.Errorf("%s: expected scope %q, got %q", name, testCase.want, got)
+		}
+	}
+}
+
+func TestResponseCache(t *testing.T) {
+	var downloads, revalidations int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"t1"`)
+		if r.Header.Get("If-None-Match") == `"t1"` {
+			revalidations++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		downloads++
+		_ = json.NewEncoder(w).Encode(TeamResponse{ID: "7", Name: "Automation"})
+	}))
+	defer server.Close()
+
+	client := &Client{ApiToken: "token", BaseUrl: server.URL, HTTPClient: server.Client(), Cache: NewResponseCache()}
+	other := &Client{ApiToken: "other", BaseUrl: server.URL, HTTPClient: server.Client(), Cache: client.Cache}
+
+	for _, c := range []*Client{client, client, other} {
+		team, err := c.GetTeam(context.Background(), "7")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if team.Name != "Automation" {
+			t.Errorf("Expected team Automation, got %q", team.Name)
+		}
+	}
+
+	// Other credentials never revalidate responses cached for the first
+	if downloads != 2 || revalidations != 1 {
+		t.Errorf("Expected 2 downloads and 1 revalidation, got %d and %d", downloads, revalidations)
+	}
+}
+
+func TestPatchFields(t *testing.T) {
+	var method string
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		body = nil
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_ = json.NewEncoder(w).Encode(ScenarioResponse{ID: "1"})
+	}))
+	defer server.Close()
+
+	client := &Client{BaseUrl: server.URL, HTTPClient: server.Client()}
+
+	testCases := map[string]struct {
+		prior          ScenarioRequest
+		req            ScenarioRequest
+		expectedMethod string
+		expected       map[string]interface{}
+	}{
+		"unchanged": {
+			prior:          ScenarioRequest{Name: "Sync", Active: true},
+			req:            ScenarioRequest{Name: "Sync", Active: true},
+			expectedMethod: "GET",
+		},
+		"changed": {
+			prior:          ScenarioRequest{Name: "Sync", Active: true},
+			req:            ScenarioRequest{Name: "Sync orders", Active: false},
+			expectedMethod: "PATCH",
+			expected:       map[string]interface{}{"name": "Sync orders", "is_active": false},
+		},
+		"added": {
+			prior:          ScenarioRequest{Name: "Sync"},
+			req:            ScenarioRequest{Name: "Sync", Scheduling: &ScenarioScheduling{Type: "indefinitely", Interval: 900}},
+			expectedMethod: "PATCH",
+			expected:       map[string]interface{}{"scheduling": map[string]interface{}{"type": "indefinitely", "interval": float64(900)}},
+		},
+		"removed": {
+			prior:          ScenarioRequest{Name: "Sync", Description: "Orders"},
+			req:            ScenarioRequest{Name: "Sync"},
+			expectedMethod: "PATCH",
+			expected:       map[string]interface{}{"description": nil},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := client.UpdateScenario(context.Background(), "1", testCase.prior, testCase.req); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if method != testCase.expectedMethod {
+				t.Errorf("Expected a %s request, got %s", testCase.expectedMethod, method)
+			}
+			if !reflect.DeepEqual(body, testCase.expected) {
+				t.Errorf("Expected body %v, got %v", testCase.expected, body)
+			}
+		})
+	}
+}
+
+func TestAuditLogsEndpoint(t *testing.T) {
+	filter := AuditLogFilter{
+		EntityType: "scenario",
+		From:       "2025-01-31T00:00:00+02:00",
+	}
+
+	endpoint := auditLogsEndpoint("123", filter, 200, 100)
+	expected := "v2/organizations/123/audit-logs?entity_type=scenario&from=2025-01-31T00%3A00%3A00%2B02%3A00&pg%5Blimit%5D=100&pg%5Boffset%5D=200"
+
+	if endpoint != expected {
+		t.Errorf("Expected endpoint %s, got %s", expected, endpoint)
+	}
+}
+
+func TestListAll(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("pg[offset]"))
+		count := min(listPageSize, 150-offset)
+
+		teams := make([]TeamResponse, count)
+		for i := range teams {
+			teams[i].ID = ID(strconv.Itoa(offset + i))
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"teams": teams})
+	}))
+	defer server.Close()
+
+	client := &Client{BaseUrl: server.URL, HTTPClient: server.Client()}
+
+	teams, err := client.ListTeams(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(teams) != 150 || teams[149].ID != "149" {
+		t.Errorf("Expected 150 teams ending with ID 149, got %d", len(teams))
+	}
+
+	expected := []string{
+		"organization_id=42&pg%5Blimit%5D=100&pg%5Boffset%5D=0",
+		"organization_id=42&pg%5Blimit%5D=100&pg%5Boffset%5D=100",
+	}
+	if strings.Join(queries, " ") != strings.Join(expected, " ") {
+		t.Errorf("Expected queries %v, got %v", expected, queries)
+	}
+}
+
+func TestListAllWorkers(t *testing.T) {
+	var mu sync.Mutex
+	var offsets []int
+	var inFlight, maxInFlight int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("pg[offset]"))
+
+		mu.Lock()
+		offsets = append(offsets, offset)
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		teams := make([]TeamResponse, max(0, min(listPageSize, 250-offset)))
+		for i := range teams {
+			teams[i].ID = ID(strconv.Itoa(offset + i))
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"teams": teams})
+	}))
+	defer server.Close()
+
+	client := &Client{BaseUrl: server.URL, HTTPClient: server.Client(), Workers: 3}
+
+	teams, err := client.ListTeams(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(teams) != 250 || teams[100].ID != "100" || teams[249].ID != "249" {
+		t.Errorf("Expected 250 teams in order, got %d", len(teams))
+	}
+
+	// The first page is fetched alone, the rest by up to 3 requests at once
+	sort.Ints(offsets)
+	if expected := []int{0, 100, 200, 300}; !reflect.DeepEqual(offsets, expected) {
+		t.Errorf("Expected offsets %v, got %v", expected, offsets)
+	}
+	if maxInFlight != 3 {
+		t.Errorf("Expected 3 requests in flight at most, got %d", maxInFlight)
+	}
+}
+
+func TestForEach(t *testing.T) {
+	items := make([]int, 20)
+	for i := range items {
+		items[i] = i
+	}
+
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	results := make([]int, len(items))
+	err := ForEach(context.Background(), 4, items, func(ctx context.Context, i int, item int) error {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+		results[i] = item * 2
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if maxInFlight > 4 {
+		t.Errorf("Expected 4 calls at once at most, got %d", maxInFlight)
+	}
+	if results[19] != 38 {
+		t.Errorf("Expected results stored by index, got %v", results)
+	}
+
+	// A failure cancels the calls still running and skips the remaining items
+	var calls atomic.Int32
+	failure := errors.New("failure")
+	err = ForEach(context.Background(), 2, items, func(ctx context.Context, i int, item int) error {
+		calls.Add(1)
+		if item == 1 {
+			return failure
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if !errors.Is(err, failure) {
+		t.Errorf("Expected the first error, got %v", err)
+	}
+	if calls.Load() > 3 {
+		t.Errorf("Expected the remaining items to be skipped, got %d calls", calls.Load())
+	}
+}
+
+func TestListAllOptions(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		queries = append(queries, r.URL.RawQuery)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"records": []DataStoreRecord{{Key: "a"}}})
+	}))
+	defer server.Close()
+
+	client := &Client{BaseUrl: server.URL, HTTPClient: server.Client()}
+
+	records, err := listAll[DataStoreRecord](context.Background(), client, "v2/data-stores/1/data", "records", listOptions{SortBy: "key", SortDir: "asc"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(records) != 1 || records[0].Key != "a" {
+		t.Errorf("Expected one record with key a, got %v", records)
+	}
+
+	expected := "pg%5Blimit%5D=100&pg%5Boffset%5D=0&pg%5BsortBy%5D=key&pg%5BsortDir%5D=asc"
+	if len(queries) != 1 || queries[0] != expected {
+		t.Errorf("Expected query %s, got %v", expected, queries)
+	}
+
+	// Missing parent objects are reported with the given error
+	_, err = client.ListDataStoreRecords(context.Background(), "missing")
+	if err == nil || err.Error() != "data store missing not found" {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestListTeamObjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("team_id") != "42" {
+			t.Errorf("Expected team_id 42, got query %s", r.URL.RawQuery)
+		}
+
+		key := strings.TrimPrefix(r.URL.Path, "/v2/")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			key: []map[string]interface{}{{"id": "1", "name": key}},
+		})
+	}))
+	defer server.Close()
+
+	client := &Client{BaseUrl: server.URL, HTTPClient: server.Client()}
+	ctx := context.Background()
+
+	scenarios, err := client.ListScenarios(ctx, "42")
+	if err != nil || len(scenarios) != 1 || scenarios[0].Name != "scenarios" {
+		t.Errorf("Expected one scenario, got %v: %v", scenarios, err)
+	}
+
+	connections, err := client.ListConnections(ctx, "42")
+	if err != nil || len(connections) != 1 || connections[0].Name != "connections" {
+		t.Errorf("Expected one connection, got %v: %v", connections, err)
+	}
+
+	webhooks, err := client.ListWebhooks(ctx, "42")
+	if err != nil || len(webhooks) != 1 || webhooks[0].Name != "webhooks" {
+		t.Errorf("Expected one webhook, got %v: %v", webhooks, err)
+	}
+}
+
+func TestOAuthTokenSource(t *testing.T) {
+	var tokenRequests int
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/v2/token" {
+			tokenRequests++
+			_ = r.ParseForm()
+			if r.Form.Get("grant_type") != "client_credentials" || r.Form.Get("client_secret") != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "token-" + strconv.Itoa(tokenRequests),
+				"expires_in":   3600,
+			})
+			return
+		}
+
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"teams": []TeamResponse{}})
+	}))
+	defer server.Close()
+
+	source := &OAuthTokenSource{
+		TokenURL:     OAuthTokenURL(server.URL + "/api/"),
+		ClientID:     "client",
+		ClientSecret: "secret",
+		HTTPClient:   server.Client(),
+	}
+	client := &Client{BaseUrl: server.URL + "/api/", HTTPClient: server.Client(), OAuth: source}
+
+	for range 2 {
+		if _, err := client.ListTeams(context.Background(), "42"); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	if tokenRequests != 1 {
+		t.Errorf("Expected the access token to be cached, got %d token requests", tokenRequests)
+	}
+
+	// A token about to expire is refreshed before the next request
+	source.expires = time.Now().Add(oauthTokenExpiryMargin / 2)
+	if _, err := client.ListTeams(context.Background(), "42"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []string{"Bearer token-1", "Bearer token-1", "Bearer token-2"}
+	if strings.Join(authorizations, " ") != strings.Join(expected, " ") {
+		t.Errorf("Expected authorizations %v, got %v", expected, authorizations)
+	}
+
+	source.ClientSecret = "wrong"
+	source.token = ""
+	if _, err := client.ListTeams(context.Background(), "42"); err == nil {
+		t.Error("Expected an error for rejected client credentials")
+	}
+}
+
+func TestAuthProfiles(t *testing.T) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"teams": []TeamResponse{}})
+	}))
+	defer server.Close()
+
+	client := &Client{
+		ApiToken:   "default-token",
+		BaseUrl:    server.URL,
+		HTTPClient: server.Client(),
+		Profiles: map[string]*Client{
+			"agency": {ApiToken: "agency-token", BaseUrl: server.URL, HTTPClient: server.Client()},
+		},
+	}
+
+	testCases := map[string]string{
+		"Token default-token": "",
+		"Token agency-token":  "agency",
+	}
+
+	for expected, profile := range testCases {
+		authorizations = nil
+
+		if _, err := client.ListTeams(WithAuthProfile(context.Background(), profile), "42"); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if len(authorizations) != 1 || authorizations[0] != expected {
+			t.Errorf("Expected authorization %q for profile %q, got %v", expected, profile, authorizations)
+		}
+	}
+
+	if _, err := client.ListTeams(WithAuthProfile(context.Background(), "missing"), "42"); err == nil {
+		t.Error("Expected an error for an unknown auth profile")
+	}
+}
+
+func TestIDUnmarshalJSON(t *testing.T) {
+	testCases := map[string]struct {
+		input    string
+		expected ID
+		wantErr  bool
+	}{
+		"string":      {input: `"123"`, expected: "123"},
+		"number":      {input: `123`, expected: "123"},
+		"large":       {input: `9007199254740993`, expected: "9007199254740993"},
+		"exponent":    {input: `1.2e3`, expected: "1200"},
+		"null":        {input: `null`, expected: ""},
+		"fraction":    {input: `1.5`, wantErr: true},
+		"bool":        {input: `true`, wantErr: true},
+		"empty array": {input: `[]`, wantErr: true},
+	}
+
+	for name, testCase := range testCases {
+		var id ID
+		err := json.Unmarshal([]byte(testCase.input), &id)
+
+		if testCase.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got ID %q", name, id)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+
+		if id != testCase.expected {
+			t.Errorf("%s: expected ID %q, got %q", name, testCase.expected, id)
+		}
+	}
+
+	// Numeric and string IDs of different entities decode the same way
+	var webhook WebhookResponse
+	if err := json.Unmarshal([]byte(`{"id":42,"team_id":"7","data_structure_id":null}`), &webhook); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if webhook.ID != "42" || webhook.TeamID != "7" || webhook.DataStructureID != "" {
+		t.Errorf("Expected IDs 42 and 7 without a data structure, got %+v", webhook)
+	}
+}
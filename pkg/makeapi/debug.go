@@ -0,0 +1,242 @@
+package makeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RedactedValue replaces secrets in logged requests and responses
+const RedactedValue = "REDACTED"
+
+// sensitiveHeaders are the headers whose values are never logged
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveFieldParts are the parts of JSON and form field names, ignoring
+// case, "_" and "-", that mark values never logged, e.g. in the settings of
+// connections
+var sensitiveFieldParts = []string{"token", "secret", "password", "apikey", "privatekey", "credential", "authorization"}
+
+// sensitiveFields are further field names whose values are never logged,
+// e.g. the material in the parameters of keychain keys
+var sensitiveFields = []string{"key", "certificate"}
+
+// secretObjectFields are, by the endpoint collection they are sent to or
+// received from, the fields holding objects whose values are all never
+// logged: the material of keychain keys and the headers audit logs are
+// exported with, which carry credentials of the destination
+var secretObjectFields = map[string]string{
+	"keys":             "parameters",
+	"audit-log-export": "headers",
+}
+
+// debugTransport logs every request to the Make API and its response at
+// TRACE level, with the secrets they carry redacted
+type debugTransport struct {
+	next http.RoundTripper
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	requestBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{
+		"method":          req.Method,
+		"url":             req.URL.Redacted(),
+		"request_headers": redactHeaders(req.Header),
+		"request_body":    redactBody(req.URL.Path, req.Header.Get("Content-Type"), requestBody),
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Trace(ctx, "Make API request failed", fields)
+		return nil, err
+	}
+
+	responseBody, err := readBody(&resp.Body)
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Trace(ctx, "Make API request failed", fields)
+		return nil, err
+	}
+
+	fields["status"] = resp.StatusCode
+	fields["response_headers"] = redactHeaders(resp.Header)
+	fields["response_body"] = redactBody(req.URL.Path, resp.Header.Get("Content-Type"), responseBody)
+	tflog.Trace(ctx, "Make API request", fields)
+
+	return resp, nil
+}
+
+// readBody reads a request or response body and replaces it with a reader of
+// the same content
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	return data, nil
+}
+
+// redactHeaders returns the headers as logged, with the values of
+// sensitiveHeaders redacted
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name, values := range header {
+		redacted[name] = strings.Join(values, ", ")
+	}
+	for _, name := range sensitiveHeaders {
+		if _, ok := redacted[name]; ok {
+			redacted[name] = RedactedValue
+		}
+	}
+
+	return redacted
+}
+
+// redactBody returns a JSON or form body exchanged with the endpoint at a URL
+// path as logged, with the values of sensitive fields redacted. Other bodies
+// are logged as they are.
+func redactBody(urlPath, contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return RedactedValue
+		}
+		for name := range form {
+			if isSensitiveField(name) {
+				form.Set(name, RedactedValue)
+			}
+		}
+		return form.Encode()
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	data, err := json.Marshal(RedactJSON(urlPath, value))
+	if err != nil {
+		return RedactedValue
+	}
+
+	return string(data)
+}
+
+// RedactJSON replaces the values of sensitive fields in a decoded JSON value
+// sent to or received from the endpoint at a URL path, and returns it.
+// Besides fields named like secrets, it redacts every value of the
+// secretObjectFields of the endpoint and the value of secret custom
+// variables. The acceptance tests of the provider redact recorded API
+// interactions with it too.
+func RedactJSON(urlPath string, value interface{}) interface{} {
+	secretObject := ""
+	for _, segment := range strings.Split(urlPath, "/") {
+		if field, ok := secretObjectFields[segment]; ok {
+			secretObject = field
+		}
+	}
+
+	return redactJSON(value, secretObject)
+}
+
+// redactJSON redacts a decoded JSON value for RedactJSON, with every value of
+// the secretObject field redacted
+func redactJSON(value interface{}, secretObject string) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, field := range value {
+			switch field.(type) {
+			case nil, bool:
+				// Flags like is_secret hold no secret
+				continue
+			}
+
+			switch {
+			case isSensitiveField(name) || isSecretValue(value, name):
+				value[name] = RedactedValue
+			case name == secretObject:
+				value[name] = redactValues(field)
+			default:
+				value[name] = redactJSON(field, secretObject)
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactJSON(item, secretObject)
+		}
+	}
+
+	return value
+}
+
+// redactValues replaces every value nested in a decoded JSON value
+func redactValues(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, field := range value {
+			value[name] = redactValues(field)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValues(item)
+		}
+	case nil:
+		return nil
+	default:
+		return RedactedValue
+	}
+
+	return value
+}
+
+// isSecretValue reports whether a field of an object is the value of a
+// secret custom variable
+func isSecretValue(object map[string]interface{}, name string) bool {
+	secret, _ := object["is_secret"].(bool)
+
+	return name == "value" && secret
+}
+
+// isSensitiveField reports whether the value of a field is a secret
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	if slices.Contains(sensitiveFields, name) {
+		return true
+	}
+	for _, part := range sensitiveFieldParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,12 @@
+// Package makeapi is a client of the Make API, used by the Terraform provider
+// and reusable by other Go tooling such as importers and CLIs.
+//
+// A Client sends requests with an API token or OAuth2 client credentials,
+// retries transient failures, bounds the requests in flight with a
+// RequestLimiter and revalidates cached GET responses with ETags. Requests of
+// a context created with WithAuthProfile use the credentials of one of the
+// client's Profiles, and WithIfMatch makes modifying requests conditional.
+//
+// Debug logs are written with tflog, i.e. to the Terraform log when the
+// context carries one and nowhere otherwise.
+package makeapi
@@ -0,0 +1,230 @@
+package makeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// requestIDHeaders are the response headers that may carry the ID Make
+// support uses to find a request
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "Request-Id"}
+
+// RequestInfo identifies the request an error response belongs to, so that
+// failures can be matched against Make support tickets
+type RequestInfo struct {
+	Method string
+	Path   string
+
+	// RequestID is the request or correlation ID of the response, empty if
+	// it carries none
+	RequestID string
+}
+
+// newRequestInfo returns the RequestInfo of a response
+func newRequestInfo(resp *http.Response) RequestInfo {
+	var info RequestInfo
+
+	if resp.Request != nil {
+		info.Method = resp.Request.Method
+		info.Path = resp.Request.URL.Path
+	}
+
+	for _, header := range requestIDHeaders {
+		if id := resp.Header.Get(header); id != "" {
+			info.RequestID = id
+			break
+		}
+	}
+
+	return info
+}
+
+// annotate appends the request to an error message
+func (r RequestInfo) annotate(message string) string {
+	var parts []string
+	if r.Method != "" {
+		parts = append(parts, r.Method+" "+r.Path)
+	}
+	if r.RequestID != "" {
+		parts = append(parts, "request ID "+r.RequestID)
+	}
+
+	if len(parts) == 0 {
+		return message
+	}
+
+	return message + " (" + strings.Join(parts, ", ") + ")"
+}
+
+// APIError is an error response of the Make API without a more specific
+// error type
+type APIError struct {
+	StatusCode int
+	Message    string
+	Request    RequestInfo
+}
+
+func (e *APIError) Error() string {
+	return e.Request.annotate(fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Message))
+}
+
+// NotFoundError reports that a requested object or its parent does not exist
+type NotFoundError struct {
+	Message string
+	Request RequestInfo
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Request.annotate(e.Message)
+}
+
+// notFoundError returns a NotFoundError with a formatted message
+func notFoundError(format string, args ...interface{}) error {
+	return &NotFoundError{Message: fmt.Sprintf(format, args...)}
+}
+
+// ConflictError reports a modifying request refused because the object no
+// longer has the ETag sent as If-Match (412), i.e. it was changed meanwhile
+type ConflictError struct {
+	StatusCode int
+	Message    string
+	Request    RequestInfo
+}
+
+func (e *ConflictError) Error() string {
+	return e.Request.annotate(fmt.Sprintf("API request failed with status %d, the object was changed meanwhile: %s", e.StatusCode, e.Message))
+}
+
+// AuthError reports credentials rejected by the API (401) or lacking the
+// permissions or scopes for a request (403)
+type AuthError struct {
+	StatusCode int
+
+	// Message is the explanation of the API, empty if it gave none
+	Message string
+
+	// Scope is the API scope the request requires, e.g. "teams:read", empty
+	// if not known
+	Scope string
+
+	Request RequestInfo
+}
+
+func (e *AuthError) Error() string {
+	var message string
+
+	switch {
+	case e.StatusCode == http.StatusForbidden && e.Scope != "":
+		message = fmt.Sprintf("access denied (status %d): the token lacks the %s scope required by this endpoint, "+
+			"or the user lacks permission for the object", e.StatusCode, e.Scope)
+	case e.StatusCode == http.StatusForbidden:
+		message = fmt.Sprintf("access denied (status %d): the token lacks a scope required by this endpoint, "+
+			"or the user lacks permission for the object", e.StatusCode)
+	default:
+		message = fmt.Sprintf("authentication failed (status %d): the API token or OAuth client is invalid, "+
+			"expired or revoked", e.StatusCode)
+	}
+
+	if e.Message != "" {
+		message += ": " + e.Message
+	}
+
+	return e.Request.annotate(message)
+}
+
+// RateLimitedError reports a request rejected by the rate limit of the API
+// despite retries
+type RateLimitedError struct {
+	StatusCode int
+	Message    string
+	Request    RequestInfo
+
+	// RetryAfter is how long the API asks to wait, zero if it does not tell
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	if e.RetryAfter > 0 {
+		return e.Request.annotate(fmt.Sprintf("API rate limit exceeded (status %d), retry after %s: %s", e.StatusCode, e.RetryAfter.Round(time.Second), e.Message))
+	}
+
+	return e.Request.annotate(fmt.Sprintf("API rate limit exceeded (status %d): %s", e.StatusCode, e.Message))
+}
+
+// ValidationError reports a request rejected as invalid (400 or 422), with
+// the problems of individual fields if the API names them
+type ValidationError struct {
+	StatusCode int
+	Message    string
+	Fields     []FieldError
+	Request    RequestInfo
+}
+
+// FieldError is a problem with a single field of a rejected request
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	message := fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Message)
+
+	var fields []string
+	for _, field := range e.Fields {
+		if field.Field != "" {
+			fields = append(fields, field.Field+": "+field.Message)
+		} else {
+			fields = append(fields, field.Message)
+		}
+	}
+	if len(fields) > 0 {
+		message += " (" + strings.Join(fields, "; ") + ")"
+	}
+
+	return e.Request.annotate(message)
+}
+
+// errOperationTimeout is the cause of contexts canceled by the operation
+// timeout of a client or WithOperationTimeout
+var errOperationTimeout = errors.New("operation timeout exceeded")
+
+// TimeoutError reports an API call that did not complete within the
+// OperationTimeout of the client, including its retries, or within the
+// timeout set by WithOperationTimeout
+type TimeoutError struct {
+	Timeout time.Duration
+	Request RequestInfo
+}
+
+func (e *TimeoutError) Error() string {
+	return e.Request.annotate(fmt.Sprintf("the Make API did not respond within the operation timeout of %s", e.Timeout))
+}
+
+// Unwrap lets errors.Is match context.DeadlineExceeded
+func (e *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// cancelOnClose cancels the context of a request once its response body is
+// closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// IsNotFound reports whether err is or wraps a NotFoundError
+func IsNotFound(err error) bool {
+	var notFound *NotFoundError
+	return errors.As(err, &notFound)
+}
@@ -0,0 +1,58 @@
+package makeapi
+
+import (
+	"context"
+	"sync"
+)
+
+// ifMatchKey is the context key of the ETag sent as If-Match
+type ifMatchKey struct{}
+
+// WithIfMatch returns a context whose modifying requests only succeed while
+// the object still has the given ETag. An empty ETag sends no condition.
+func WithIfMatch(ctx context.Context, etag string) context.Context {
+	if etag == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, ifMatchKey{}, etag)
+}
+
+// etagRecorderKey is the context key of the ETagRecorder
+type etagRecorderKey struct{}
+
+// ETagRecorder captures the ETag of the first successful response to a
+// request made with its context, i.e. of the object a resource operation
+// reads or writes rather than of objects read later on
+type ETagRecorder struct {
+	mu       sync.Mutex
+	etag     string
+	recorded bool
+}
+
+// WithETagRecorder returns a context recording ETags into the returned
+// recorder
+func WithETagRecorder(ctx context.Context) (context.Context, *ETagRecorder) {
+	recorder := &ETagRecorder{}
+	return context.WithValue(ctx, etagRecorderKey{}, recorder), recorder
+}
+
+// record captures the ETag of a successful response unless one was recorded
+// already
+func (r *ETagRecorder) record(etag string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.recorded {
+		r.etag = etag
+		r.recorded = true
+	}
+}
+
+// ETag returns the recorded ETag, empty if the API sent none
+func (r *ETagRecorder) ETag() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.etag
+}
@@ -0,0 +1,66 @@
+package makeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// ID identifies a Make.com object, e.g. a scenario or a team. The API returns
+// most IDs as JSON numbers but some as strings, depending on the entity and
+// the endpoint. ID accepts both forms and always holds the decimal string form,
+// so that IDs of every entity compare and format the same. It is sent as a
+// JSON string, which the API accepts wherever it takes an ID.
+type ID string
+
+// String returns the ID as a string
+func (id ID) String() string {
+	return string(id)
+}
+
+// UnmarshalJSON decodes an ID given as a JSON string or number. Numbers are
+// normalized to their decimal form without exponent, e.g. 1.2e3 to "1200",
+// and null decodes to the empty ID.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*id = ""
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		*id = ID(value)
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return fmt.Errorf("invalid ID %s: must be a string or a number", data)
+	}
+
+	value, _, err := big.ParseFloat(number.String(), 10, 256, big.ToNearestEven)
+	if err != nil || !value.IsInt() {
+		return fmt.Errorf("invalid ID %s: must be a whole number", data)
+	}
+
+	*id = ID(value.Text('f', 0))
+	return nil
+}
+
+// IDStrings returns ids as strings
+func IDStrings(ids []ID) []string {
+	if ids == nil {
+		return nil
+	}
+
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = string(id)
+	}
+	return values
+}
@@ -0,0 +1,40 @@
+package makeapi
+
+import (
+	"context"
+)
+
+// RequestLimiter bounds the number of API requests in flight. The nil
+// limiter does not limit requests.
+type RequestLimiter chan struct{}
+
+// NewRequestLimiter returns a limiter allowing up to limit concurrent
+// requests, or nil when limit is not positive
+func NewRequestLimiter(limit int) RequestLimiter {
+	if limit <= 0 {
+		return nil
+	}
+
+	return make(RequestLimiter, limit)
+}
+
+// acquire waits for a free request slot, unless ctx is done first
+func (l RequestLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	select {
+	case l <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release frees a slot taken by acquire
+func (l RequestLimiter) release() {
+	if l != nil {
+		<-l
+	}
+}
@@ -0,0 +1,120 @@
+package makeapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// oauthTokenExpiryMargin is how long before its expiry an access token is
+// refreshed, so that it does not expire while a request is in flight
+const oauthTokenExpiryMargin = time.Minute
+
+// OAuthTokenSource obtains access tokens with the OAuth2 client credentials
+// grant and caches them until shortly before they expire
+type OAuthTokenSource struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	HTTPClient   *http.Client
+	UserAgent    string
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// oauthTokenResponse represents a successful response of the token endpoint
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// OAuthTokenURL returns the default token endpoint of the zone of a base URL
+func OAuthTokenURL(baseUrl string) string {
+	u, err := url.Parse(baseUrl)
+	if err != nil {
+		return ""
+	}
+
+	return u.Scheme + "://" + u.Host + "/oauth/v2/token"
+}
+
+// Token returns a valid access token, requesting a new one when none was
+// obtained yet or the cached one is about to expire
+func (s *OAuthTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Add(oauthTokenExpiryMargin).Before(s.expires) {
+		return s.token, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", s.ClientID)
+	form.Set("client_secret", s.ClientSecret)
+	if len(s.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.Scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if s.UserAgent != "" {
+		req.Header.Set("User-Agent", s.UserAgent)
+	}
+
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request access token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// The token endpoint rejects unknown clients and wrong secrets with 400
+	// or 401
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+		var oauthErr struct {
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&oauthErr)
+
+		message := oauthErr.Error
+		if oauthErr.Description != "" {
+			message += ": " + oauthErr.Description
+		}
+
+		return "", &AuthError{StatusCode: resp.StatusCode, Message: strings.TrimPrefix(message, ": "), Request: newRequestInfo(resp)}
+	}
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("failed to request access token, token endpoint returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var token oauthTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("token endpoint returned no access token")
+	}
+
+	s.token = token.AccessToken
+	s.expires = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+
+	return s.token, nil
+}
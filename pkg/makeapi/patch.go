@@ -0,0 +1,52 @@
+package makeapi
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// patchFields returns the JSON fields of an update request that differ from
+// the request built from the prior state, to be sent with PATCH so that
+// fields the provider does not model are left as they are in Make. Fields
+// omitted from the request but present in the prior one, e.g. an optional
+// attribute removed from the configuration, are sent as null to clear them.
+func patchFields(prior, req interface{}) (map[string]json.RawMessage, error) {
+	before, err := jsonFields(prior)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := jsonFields(req)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	for name, value := range after {
+		if !bytes.Equal(before[name], value) {
+			fields[name] = value
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			fields[name] = json.RawMessage("null")
+		}
+	}
+
+	return fields, nil
+}
+
+// jsonFields returns the JSON encoding of each field of a request
+func jsonFields(req interface{}) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,67 @@
+package makeapi
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultWorkers is how many requests a single call fans out to at once
+// unless the client configures Workers
+const DefaultWorkers = 4
+
+// ForEach calls fn for every item with at most workers calls running at once,
+// e.g. to read the details of many objects. fn receives the index of the item
+// so that it can store results without locking. The context passed to fn is
+// canceled once a call fails, and the first error is returned.
+func ForEach[T any](ctx context.Context, workers int, items []T, fn func(ctx context.Context, i int, item T) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+
+	type job struct {
+		index int
+		item  T
+	}
+	jobs := make(chan job)
+
+	for range min(max(workers, 1), len(items)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				// Items received after a failure are skipped
+				if ctx.Err() != nil {
+					continue
+				}
+				if err := fn(ctx, job.index, job.item); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+feed:
+	for i, item := range items {
+		select {
+		case jobs <- job{index: i, item: item}:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err()
+}
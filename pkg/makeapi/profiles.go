@@ -0,0 +1,32 @@
+package makeapi
+
+import (
+	"context"
+	"fmt"
+)
+
+// authProfileKey is the context key holding the name of the auth profile
+// requests are sent with
+type authProfileKey struct{}
+
+// WithAuthProfile returns a context whose API requests are sent with the
+// credentials of the named auth profile of the client, see Profiles
+func WithAuthProfile(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, authProfileKey{}, name)
+}
+
+// ProfileClient returns the client holding the credentials of the auth
+// profile selected in ctx, or c itself when no profile was selected
+func (c *Client) ProfileClient(ctx context.Context) (*Client, error) {
+	name, ok := ctx.Value(authProfileKey{}).(string)
+	if !ok || name == "" {
+		return c, nil
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("auth profile %q is not configured in the provider auth_profiles", name)
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,45 @@
+package makeapi
+
+import "sync"
+
+// quotaWarningPercent is the share of the API quota left below which the
+// provider warns that requests will soon be throttled
+const quotaWarningPercent = 10
+
+// QuotaMonitor tracks the API quota reported by responses so that a nearly
+// exhausted quota is reported once per Terraform run
+type QuotaMonitor struct {
+	mu     sync.Mutex
+	low    *RateLimit
+	warned bool
+}
+
+// observe records the quota reported by a response if it is nearly exhausted
+func (m *QuotaMonitor) observe(quota RateLimit) {
+	if m == nil || quota.Limit <= 0 || quota.Remaining*100 > quota.Limit*quotaWarningPercent {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.low = &quota
+}
+
+// Warning returns the nearly exhausted quota the first time it is called
+// after one was observed
+func (m *QuotaMonitor) Warning() (RateLimit, bool) {
+	if m == nil {
+		return RateLimit{}, false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.low == nil || m.warned {
+		return RateLimit{}, false
+	}
+	m.warned = true
+
+	return *m.low, true
+}
@@ -0,0 +1,87 @@
+package makeapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxRetryAfter is the longest wait for a rate limit to reset that a request
+// is retried after. Longer waits surface the rate limit error instead of
+// stalling the Terraform run.
+const maxRetryAfter = 2 * time.Minute
+
+// RateLimit is the API quota reported by the X-RateLimit-* response headers
+type RateLimit struct {
+	// Limit is the number of requests allowed per window
+	Limit int
+
+	// Remaining is the number of requests left in the current window
+	Remaining int
+
+	// Reset is when the current window ends, zero if not reported
+	Reset time.Time
+}
+
+// parseRateLimit reads the X-RateLimit-* headers of a response. It reports
+// false when the response carries no quota.
+func parseRateLimit(header http.Header, now time.Time) (RateLimit, bool) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+
+	limit, _ := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+
+	quota := RateLimit{Limit: limit, Remaining: remaining}
+
+	// The reset is given either as a Unix timestamp or in seconds from now.
+	// No rate limit window lasts a year, so larger values are timestamps.
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil && reset >= 0 {
+		if reset > int64(365*24*time.Hour/time.Second) {
+			quota.Reset = time.Unix(reset, 0)
+		} else {
+			quota.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+
+	return quota, true
+}
+
+// retryAfter returns how long a rate limited response asks to wait before
+// retrying, from the Retry-After header or else the reset of an exhausted
+// quota. It reports false when the response gives no wait.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if value := strings.TrimSpace(resp.Header.Get("Retry-After")); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+
+		if date, err := http.ParseTime(value); err == nil {
+			return max(date.Sub(now), 0), true
+		}
+	}
+
+	if quota, ok := parseRateLimit(resp.Header, now); ok && quota.Remaining == 0 && !quota.Reset.IsZero() {
+		return max(quota.Reset.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+// logRateLimit logs the remaining API quota reported by a response
+func logRateLimit(ctx context.Context, quota RateLimit) {
+	fields := map[string]interface{}{
+		"limit":     quota.Limit,
+		"remaining": quota.Remaining,
+	}
+	if !quota.Reset.IsZero() {
+		fields["reset"] = quota.Reset.Format(time.RFC3339)
+	}
+
+	tflog.Debug(ctx, "Make API rate limit", fields)
+}
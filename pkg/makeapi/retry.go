@@ -0,0 +1,67 @@
+package makeapi
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+)
+
+// DefaultMaxRetries is how often a request failing with a transient error is
+// retried unless the provider configures max_retries
+const DefaultMaxRetries = 3
+
+// retryBaseDelay and retryMaxDelay bound the exponential backoff between
+// retries. They are variables so that tests can shorten them.
+var (
+	retryBaseDelay = time.Second
+	retryMaxDelay  = 30 * time.Second
+)
+
+// isRetryableStatus reports whether a response status of a request is a
+// transient error worth retrying: rate limiting, which the API rejects before
+// acting on the request, and server errors other than 501, which no retry will
+// fix. Server errors are only retried for idempotent methods, as a POST may
+// have created an object before failing.
+func isRetryableStatus(method string, status int) bool {
+	if status == http.StatusTooManyRequests {
+		return true
+	}
+
+	return status >= 500 && status != http.StatusNotImplemented && isIdempotent(method)
+}
+
+// isIdempotent reports whether requests of a method can be repeated without
+// changing their effect. PATCH requests of the client set fields to values,
+// so they are safe to repeat too.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodPatch:
+		return true
+	default:
+		return false
+	}
+}
+
+// isConnectError reports whether a request failed before a connection to the
+// API was established, so that it was never sent and can be retried whatever
+// its method
+func isConnectError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// retryBackoff returns how long to wait before the given retry, counted from
+// zero. The delay doubles with every retry up to retryMaxDelay, and half of it
+// is randomized so that parallel operations do not retry in lockstep.
+func retryBackoff(retry int) time.Duration {
+	delay := retryMaxDelay
+	if retry < 16 {
+		delay = min(retryBaseDelay<<retry, retryMaxDelay)
+	}
+
+	half := delay / 2
+
+	return half + rand.N(half+1)
+}
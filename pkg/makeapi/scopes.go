@@ -0,0 +1,63 @@
+package makeapi
+
+import (
+	"net/http"
+	"strings"
+)
+
+// apiScopes maps the path segments of API endpoints to the scope granting
+// access to them, without the ":read" or ":write" suffix
+var apiScopes = map[string]string{
+	"api-tokens":               "user",
+	"apps":                     "apps",
+	"audit-log-export":         "audit-logs",
+	"audit-logs":               "audit-logs",
+	"connections":              "connections",
+	"data-stores":              "datastores",
+	"data-structures":          "udts",
+	"functions":                "functions",
+	"hooks":                    "hooks",
+	"ip-allowlist":             "organizations",
+	"keys":                     "keys",
+	"notification-preferences": "user",
+	"organizations":            "organizations",
+	"scenarios":                "scenarios",
+	"scim":                     "scim",
+	"sdk":                      "sdk-apps",
+	"teams":                    "teams",
+	"users":                    "user",
+	"webhooks":                 "hooks",
+}
+
+// requiredScope returns the API scope a request needs, e.g. "teams:read" for
+// reading a team, or an empty string if the endpoint is not known. The most
+// specific path segment decides, so variables of a team need the
+// team-variables scope rather than teams.
+func requiredScope(method, urlPath string) string {
+	var scope, parent string
+
+	for _, segment := range strings.Split(urlPath, "/") {
+		switch {
+		case segment == "variables" && parent == "teams":
+			scope = "team-variables"
+		case segment == "variables" && parent == "organizations":
+			scope = "organization-variables"
+		case segment == "users" && (parent == "teams" || parent == "organizations"):
+			// Members are managed with the scope of their team or organization
+		case segment == "apps" && parent == "sdk":
+		case apiScopes[segment] != "":
+			scope = apiScopes[segment]
+			parent = segment
+		}
+	}
+
+	if scope == "" {
+		return ""
+	}
+
+	if method == http.MethodGet || method == http.MethodHead {
+		return scope + ":read"
+	}
+
+	return scope + ":write"
+}
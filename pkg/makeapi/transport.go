@@ -0,0 +1,128 @@
+package makeapi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// DefaultRequestTimeout bounds API requests unless the provider configures
+// request_timeout
+const DefaultRequestTimeout = 30 * time.Second
+
+// TLSVersions maps the values of the tls_min_version provider attribute to
+// TLS versions
+var TLSVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// TransportConfig holds the provider settings of the HTTP client talking to
+// the Make API
+type TransportConfig struct {
+	// Timeout bounds each request, including reading the response body
+	Timeout time.Duration
+
+	// TLSMinVersion is the minimum TLS version accepted from the API
+	TLSMinVersion uint16
+
+	// CABundleFile is a PEM file of certificate authorities trusted in
+	// addition to the system ones, e.g. of a TLS intercepting gateway
+	CABundleFile string
+
+	// ProxyURL is the proxy requests are sent through, optionally with
+	// credentials. Empty to use the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+	// environment variables.
+	ProxyURL string
+
+	// ClientCertificateFile and ClientKeyFile are the PEM files of a client
+	// certificate presented for mutual TLS
+	ClientCertificateFile string
+	ClientKeyFile         string
+
+	// Debug logs every request and response, including their bodies, at
+	// TRACE level with secrets redacted
+	Debug bool
+}
+
+// NewHTTPClient returns the HTTP client for the Make API
+func NewHTTPClient(config TransportConfig) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: config.TLSMinVersion,
+	}
+
+	if config.CABundleFile != "" {
+		bundle, err := os.ReadFile(config.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("no PEM certificates found in CA bundle %s", config.CABundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertificateFile != "" || config.ClientKeyFile != "" {
+		if config.ClientCertificateFile == "" || config.ClientKeyFile == "" {
+			return nil, fmt.Errorf("client certificate and key must be configured together")
+		}
+
+		certificate, err := tls.LoadX509KeyPair(config.ClientCertificateFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy = http.ProxyFromEnvironment
+
+	if config.ProxyURL != "" {
+		proxyURL, err := parseProxyURL(config.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	client := &http.Client{
+		Timeout:   config.Timeout,
+		Transport: transport,
+	}
+	if config.Debug {
+		client.Transport = &debugTransport{next: transport}
+	}
+
+	return client, nil
+}
+
+// parseProxyURL parses the URL of an HTTP(S) or SOCKS5 proxy. Credentials
+// given as user info authenticate with the proxy.
+func parseProxyURL(value string) (*url.URL, error) {
+	proxyURL, err := url.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %s: the scheme must be http, https or socks5", proxyURL.Redacted())
+	}
+
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %s: missing host", proxyURL.Redacted())
+	}
+
+	return proxyURL, nil
+}